@@ -0,0 +1,262 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// accountSnapshotRetention is how far back binance keeps snapshots
+	accountSnapshotRetention = 30 * 24 * time.Hour
+	maxAccountSnapshotLimit  = 30
+)
+
+// ErrSnapshotOutOfRetention is returned by the snapshot iterators when the requested range is older than 30 days
+var ErrSnapshotOutOfRetention = errors.New("account snapshot range is out of retention")
+
+// SpotAccountSnapshot is the daily snapshot of the spot account
+type SpotAccountSnapshot struct {
+	Type       string `json:"type"`
+	UpdateTime int64  `json:"updateTime"`
+	Data       struct {
+		TotalAssetOfBtc decimal.Decimal `json:"totalAssetOfBtc"`
+		Balances        []struct {
+			Asset  string          `json:"asset"`
+			Free   decimal.Decimal `json:"free"`
+			Locked decimal.Decimal `json:"locked"`
+		} `json:"balances"`
+	} `json:"data"`
+}
+
+// MarginAccountSnapshot is the daily snapshot of the cross margin account
+type MarginAccountSnapshot struct {
+	Type       string `json:"type"`
+	UpdateTime int64  `json:"updateTime"`
+	Data       struct {
+		MarginLevel         decimal.Decimal `json:"marginLevel"`
+		TotalAssetOfBtc     decimal.Decimal `json:"totalAssetOfBtc"`
+		TotalLiabilityOfBtc decimal.Decimal `json:"totalLiabilityOfBtc"`
+		TotalNetAssetOfBtc  decimal.Decimal `json:"totalNetAssetOfBtc"`
+		UserAssets          []struct {
+			Asset    string          `json:"asset"`
+			Borrowed decimal.Decimal `json:"borrowed"`
+			Free     decimal.Decimal `json:"free"`
+			Interest decimal.Decimal `json:"interest"`
+			Locked   decimal.Decimal `json:"locked"`
+			NetAsset decimal.Decimal `json:"netAsset"`
+		} `json:"userAssets"`
+	} `json:"data"`
+}
+
+// FutureAccountSnapshot is the daily snapshot of the USD-M futures account
+type FutureAccountSnapshot struct {
+	Type       string `json:"type"`
+	UpdateTime int64  `json:"updateTime"`
+	Data       struct {
+		Assets []struct {
+			Asset         string          `json:"asset"`
+			MarginBalance decimal.Decimal `json:"marginBalance"`
+			WalletBalance decimal.Decimal `json:"walletBalance"`
+		} `json:"assets"`
+		Position []struct {
+			Symbol           string          `json:"symbol"`
+			EntryPrice       decimal.Decimal `json:"entryPrice"`
+			MarkPrice        decimal.Decimal `json:"markPrice"`
+			PositionAmt      decimal.Decimal `json:"positionAmt"`
+			UnRealizedProfit decimal.Decimal `json:"unRealizedProfit"`
+		} `json:"position"`
+	} `json:"data"`
+}
+
+// GetSpotAccountSnapshots return daily snapshots of the spot account, limit is between 7 and 30
+func (bc *Client) GetSpotAccountSnapshots(startTime, endTime int64, limit int) ([]SpotAccountSnapshot, *FwdData, error) {
+	var (
+		result struct {
+			SnapshotVos []SpotAccountSnapshot `json:"snapshotVos"`
+		}
+	)
+	fwd, err := bc.getAccountSnapshots("SPOT", startTime, endTime, limit, &result)
+	return result.SnapshotVos, fwd, err
+}
+
+// GetMarginAccountSnapshots return daily snapshots of the cross margin account, limit is between 7 and 30
+func (bc *Client) GetMarginAccountSnapshots(startTime, endTime int64, limit int) ([]MarginAccountSnapshot, *FwdData, error) {
+	var (
+		result struct {
+			SnapshotVos []MarginAccountSnapshot `json:"snapshotVos"`
+		}
+	)
+	fwd, err := bc.getAccountSnapshots("MARGIN", startTime, endTime, limit, &result)
+	return result.SnapshotVos, fwd, err
+}
+
+// GetFutureAccountSnapshots return daily snapshots of the USD-M futures account, limit is between 7 and 30
+func (bc *Client) GetFutureAccountSnapshots(startTime, endTime int64, limit int) ([]FutureAccountSnapshot, *FwdData, error) {
+	var (
+		result struct {
+			SnapshotVos []FutureAccountSnapshot `json:"snapshotVos"`
+		}
+	)
+	fwd, err := bc.getAccountSnapshots("FUTURES", startTime, endTime, limit, &result)
+	return result.SnapshotVos, fwd, err
+}
+
+func (bc *Client) getAccountSnapshots(accountType string, startTime, endTime int64, limit int, result interface{}) (*FwdData, error) {
+	requestURL := fmt.Sprintf("%s/sapi/v1/accountSnapshot", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("type", accountType)
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	return bc.doRequest(rr.SignedRequest(bc.secretKey), result)
+}
+
+// snapshotWindow is the time range of a snapshot iterator, binance only keeps 30 days of snapshots which is also the
+// max range of a query, so any valid range is fetched with a single request
+type snapshotWindow struct {
+	start int64
+	end   int64
+	done  bool
+	err   error
+}
+
+// newSnapshotWindow default a zero endTime to now and a zero startTime to 30 days before endTime, it fails with
+// ErrSnapshotOutOfRetention when the range starts before the oldest snapshot binance keeps
+func newSnapshotWindow(startTime, endTime int64) snapshotWindow {
+	now := int64(currentMillis())
+	oldest := now - accountSnapshotRetention.Milliseconds() + 1
+	if endTime == 0 || endTime > now {
+		endTime = now
+	}
+	if startTime == 0 {
+		startTime = endTime - accountSnapshotRetention.Milliseconds() + 1
+		if startTime < oldest {
+			startTime = oldest
+		}
+	}
+	if startTime < oldest || endTime < oldest {
+		return snapshotWindow{done: true, err: fmt.Errorf("%w, range %d-%d starts before %d",
+			ErrSnapshotOutOfRetention, startTime, endTime, oldest)}
+	}
+	return snapshotWindow{start: startTime, end: endTime, done: startTime > endTime}
+}
+
+// next call fetch with the whole range once, it returns false when the range was already fetched, is empty or fetch
+// failed
+func (w *snapshotWindow) next(fetch func(start, end int64) (int, error)) bool {
+	if w.done {
+		return false
+	}
+	w.done = true
+	rows, err := fetch(w.start, w.end)
+	if err != nil {
+		w.err = err
+		return false
+	}
+	return rows > 0
+}
+
+// SpotAccountSnapshotIterator page through spot account snapshots of the last 30 days
+type SpotAccountSnapshotIterator struct {
+	bc     *Client
+	window snapshotWindow
+	page   []SpotAccountSnapshot
+}
+
+// NewSpotAccountSnapshotIterator create an iterator over spot account snapshots between startTime and endTime, a zero
+// endTime means now and a zero startTime 30 days before endTime, Err return
+// ErrSnapshotOutOfRetention if the range starts more than 30 days ago
+func (bc *Client) NewSpotAccountSnapshotIterator(startTime, endTime int64) *SpotAccountSnapshotIterator {
+	return &SpotAccountSnapshotIterator{bc: bc, window: newSnapshotWindow(startTime, endTime)}
+}
+
+// Next fetch the snapshots of the range, it returns false when there is no more snapshot or an error occurred
+func (it *SpotAccountSnapshotIterator) Next() bool {
+	return it.window.next(func(start, end int64) (int, error) {
+		var err error
+		it.page, _, err = it.bc.GetSpotAccountSnapshots(start, end, maxAccountSnapshotLimit)
+		return len(it.page), err
+	})
+}
+
+// Page return the snapshots fetched by the last call to Next
+func (it *SpotAccountSnapshotIterator) Page() []SpotAccountSnapshot {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *SpotAccountSnapshotIterator) Err() error {
+	return it.window.err
+}
+
+// MarginAccountSnapshotIterator page through cross margin account snapshots of the last 30 days
+type MarginAccountSnapshotIterator struct {
+	bc     *Client
+	window snapshotWindow
+	page   []MarginAccountSnapshot
+}
+
+// NewMarginAccountSnapshotIterator create an iterator over cross margin account snapshots between startTime and
+// endTime, a zero endTime means now and a zero startTime 30 days before endTime, Err return
+// ErrSnapshotOutOfRetention if the range starts more than 30 days ago
+func (bc *Client) NewMarginAccountSnapshotIterator(startTime, endTime int64) *MarginAccountSnapshotIterator {
+	return &MarginAccountSnapshotIterator{bc: bc, window: newSnapshotWindow(startTime, endTime)}
+}
+
+// Next fetch the snapshots of the range, it returns false when there is no more snapshot or an error occurred
+func (it *MarginAccountSnapshotIterator) Next() bool {
+	return it.window.next(func(start, end int64) (int, error) {
+		var err error
+		it.page, _, err = it.bc.GetMarginAccountSnapshots(start, end, maxAccountSnapshotLimit)
+		return len(it.page), err
+	})
+}
+
+// Page return the snapshots fetched by the last call to Next
+func (it *MarginAccountSnapshotIterator) Page() []MarginAccountSnapshot {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *MarginAccountSnapshotIterator) Err() error {
+	return it.window.err
+}
+
+// FutureAccountSnapshotIterator page through USD-M futures account snapshots of the last 30 days
+type FutureAccountSnapshotIterator struct {
+	bc     *Client
+	window snapshotWindow
+	page   []FutureAccountSnapshot
+}
+
+// NewFutureAccountSnapshotIterator create an iterator over USD-M futures account snapshots between startTime and
+// endTime, a zero endTime means now and a zero startTime 30 days before endTime, Err return
+// ErrSnapshotOutOfRetention if the range starts more than 30 days ago
+func (bc *Client) NewFutureAccountSnapshotIterator(startTime, endTime int64) *FutureAccountSnapshotIterator {
+	return &FutureAccountSnapshotIterator{bc: bc, window: newSnapshotWindow(startTime, endTime)}
+}
+
+// Next fetch the snapshots of the range, it returns false when there is no more snapshot or an error occurred
+func (it *FutureAccountSnapshotIterator) Next() bool {
+	return it.window.next(func(start, end int64) (int, error) {
+		var err error
+		it.page, _, err = it.bc.GetFutureAccountSnapshots(start, end, maxAccountSnapshotLimit)
+		return len(it.page), err
+	})
+}
+
+// Page return the snapshots fetched by the last call to Next
+func (it *FutureAccountSnapshotIterator) Page() []FutureAccountSnapshot {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *FutureAccountSnapshotIterator) Err() error {
+	return it.window.err
+}
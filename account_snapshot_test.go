@@ -0,0 +1,116 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAccountSnapshotIteratorRange(t *testing.T) {
+	day := (24 * time.Hour).Milliseconds()
+	now := int64(currentMillis())
+	tests := []struct {
+		name      string
+		startTime int64
+		endTime   int64
+		wantCalls int
+		wantStart int64 // expected startTime of the first call, approximately when wantNow
+		wantEnd   int64
+		wantNow   bool // wantStart and wantEnd are relative to now and compared with a tolerance
+		wantErr   bool
+	}{
+		{"default range", 0, 0, 1, -30*day + 1, 0, true, false},
+		{"future end is clamped to now", now - 10*day, now + 10*day, 1, now - 10*day, 0, false, false},
+		{"recent range", now - 10*day, now - 5*day, 1, now - 10*day, now - 5*day, false, false},
+		{"start at epoch", 1, 0, 0, 0, 0, false, true},
+		{"range out of retention", now - 90*day, now - 60*day, 0, 0, 0, false, true},
+		{"default start with end out of retention", 0, now - 60*day, 0, 0, 0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu    sync.Mutex
+				calls [][2]int64
+			)
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				start, _ := strconv.ParseInt(r.URL.Query().Get("startTime"), 10, 64)
+				end, _ := strconv.ParseInt(r.URL.Query().Get("endTime"), 10, 64)
+				mu.Lock()
+				calls = append(calls, [2]int64{start, end})
+				mu.Unlock()
+				if r.URL.Query().Get("type") != "SPOT" {
+					t.Errorf("type = %s", r.URL.Query().Get("type"))
+				}
+				writeJSON(t, w, map[string]interface{}{
+					"code":        200,
+					"snapshotVos": []map[string]interface{}{{"type": "spot", "updateTime": end}},
+				})
+			})
+			it := bc.NewSpotAccountSnapshotIterator(tt.startTime, tt.endTime)
+			pages := 0
+			for it.Next() {
+				pages++
+				if len(it.Page()) != 1 {
+					t.Fatalf("page = %v", it.Page())
+				}
+			}
+			if err := it.Err(); (err != nil) != tt.wantErr || (err != nil && !errors.Is(err, ErrSnapshotOutOfRetention)) {
+				t.Fatalf("Err() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(calls) != tt.wantCalls || pages != tt.wantCalls {
+				t.Fatalf("calls = %v, pages = %d, want %d", calls, pages, tt.wantCalls)
+			}
+			if tt.wantCalls == 0 {
+				return
+			}
+			start, end := calls[0][0], calls[0][1]
+			if end-start >= accountSnapshotRetention.Milliseconds() {
+				t.Fatalf("range %d-%d is longer than 30 days", start, end)
+			}
+			if tt.wantNow {
+				if d := start - (now + tt.wantStart); d < 0 || d > 5000 {
+					t.Fatalf("start = %d, want about now%+d", start, tt.wantStart)
+				}
+				if d := end - now; d < 0 || d > 5000 {
+					t.Fatalf("end = %d, want about now", end)
+				}
+				return
+			}
+			if tt.wantEnd == 0 {
+				if d := end - now; start != tt.wantStart || d < 0 || d > 5000 {
+					t.Fatalf("range = %d-%d, want %d-now", start, end, tt.wantStart)
+				}
+				return
+			}
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Fatalf("range = %d-%d, want %d-%d", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestAccountSnapshotIteratorEmpty(t *testing.T) {
+	day := (24 * time.Hour).Milliseconds()
+	now := int64(currentMillis())
+	calls := 0
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeJSON(t, w, map[string]interface{}{"snapshotVos": []map[string]interface{}{}})
+	})
+	it := bc.NewFutureAccountSnapshotIterator(now-20*day, now)
+	if it.Next() {
+		t.Fatalf("unexpected page %+v", it.Page())
+	}
+	if it.Next() {
+		t.Fatal("unexpected page")
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+}
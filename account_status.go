@@ -0,0 +1,159 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// SystemStatus ...
+type SystemStatus struct {
+	Status int    `json:"status"` // 0 normal, 1 maintenance
+	Msg    string `json:"msg"`
+}
+
+// IsNormal return true if the system is not in maintenance
+func (s SystemStatus) IsNormal() bool {
+	return s.Status == 0
+}
+
+// APITradingStatus ...
+type APITradingStatus struct {
+	IsLocked           bool             `json:"isLocked"`
+	PlannedRecoverTime int64            `json:"plannedRecoverTime"`
+	TriggerCondition   map[string]int64 `json:"triggerCondition"`
+	UpdateTime         int64            `json:"updateTime"`
+}
+
+// APIRestrictions is the permissions of the api key
+type APIRestrictions struct {
+	IPRestrict                     bool  `json:"ipRestrict"`
+	CreateTime                     int64 `json:"createTime"`
+	EnableReading                  bool  `json:"enableReading"`
+	EnableSpotAndMarginTrading     bool  `json:"enableSpotAndMarginTrading"`
+	EnableMargin                   bool  `json:"enableMargin"`
+	EnableFutures                  bool  `json:"enableFutures"`
+	EnableWithdrawals              bool  `json:"enableWithdrawals"`
+	EnableInternalTransfer         bool  `json:"enableInternalTransfer"`
+	PermitsUniversalTransfer       bool  `json:"permitsUniversalTransfer"`
+	EnableVanillaOptions           bool  `json:"enableVanillaOptions"`
+	EnablePortfolioMarginTrading   bool  `json:"enablePortfolioMarginTrading"`
+	TradingAuthorityExpirationTime int64 `json:"tradingAuthorityExpirationTime"`
+}
+
+// APIPermissions is the permissions a strategy needs from its api key
+type APIPermissions struct {
+	SpotTrading       bool
+	Margin            bool
+	Futures           bool
+	Withdrawals       bool
+	UniversalTransfer bool
+	IPRestricted      bool // the key must be restricted to trusted ips, binance requires it for withdrawals
+}
+
+// Missing return the required permissions the key does not have
+func (r APIRestrictions) Missing(required APIPermissions) []string {
+	var missing []string
+	for _, p := range []struct {
+		required, enabled bool
+		name              string
+	}{
+		{true, r.EnableReading, "reading"},
+		{required.SpotTrading, r.EnableSpotAndMarginTrading, "spot and margin trading"},
+		{required.Margin, r.EnableMargin && r.EnableSpotAndMarginTrading, "margin"},
+		{required.Futures, r.EnableFutures, "futures"},
+		{required.Withdrawals, r.EnableWithdrawals, "withdrawals"},
+		{required.UniversalTransfer, r.PermitsUniversalTransfer, "universal transfer"},
+		{required.IPRestricted, r.IPRestrict, "ip restriction"},
+	} {
+		if p.required && !p.enabled {
+			missing = append(missing, p.name)
+		}
+	}
+	return missing
+}
+
+// GetSystemStatus return whether binance is in maintenance
+func (bc *Client) GetSystemStatus() (SystemStatus, *FwdData, error) {
+	var (
+		result SystemStatus
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/system/status", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	fwd, err := bc.doRequest(req.Request(), &result)
+	return result, fwd, err
+}
+
+// GetAccountStatus return the account status, "Normal" if it is not restricted
+func (bc *Client) GetAccountStatus() (string, *FwdData, error) {
+	var (
+		result struct {
+			Data string `json:"data"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/account/status", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result.Data, fwd, err
+}
+
+// GetAPITradingStatus return whether api trading is locked by the trading rules
+func (bc *Client) GetAPITradingStatus() (APITradingStatus, *FwdData, error) {
+	var (
+		result struct {
+			Data APITradingStatus `json:"data"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/account/apiTradingStatus", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result.Data, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result.Data, fwd, err
+}
+
+// GetAPIRestrictions return the permissions of the api key
+func (bc *Client) GetAPIRestrictions() (APIRestrictions, *FwdData, error) {
+	var (
+		result APIRestrictions
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/account/apiRestrictions", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// CheckAPIPermissions fail if the api key lacks a required permission or, when trading is required, if api trading
+// is locked. It is meant to run before a strategy starts.
+func (bc *Client) CheckAPIPermissions(required APIPermissions) error {
+	restrictions, _, err := bc.GetAPIRestrictions()
+	if err != nil {
+		return fmt.Errorf("failed to get api restrictions, %w", err)
+	}
+	if missing := restrictions.Missing(required); len(missing) > 0 {
+		return fmt.Errorf("api key is missing permissions: %s", strings.Join(missing, ", "))
+	}
+	if required.SpotTrading || required.Margin {
+		status, _, err := bc.GetAPITradingStatus()
+		if err != nil {
+			return fmt.Errorf("failed to get api trading status, %w", err)
+		}
+		if status.IsLocked {
+			return fmt.Errorf("api trading is locked until %d", status.PlannedRecoverTime)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestAPIRestrictionsMissing(t *testing.T) {
+	tests := []struct {
+		name         string
+		restrictions APIRestrictions
+		required     APIPermissions
+		want         []string
+	}{
+		{
+			name:         "reading only",
+			restrictions: APIRestrictions{EnableReading: true},
+			required:     APIPermissions{},
+		},
+		{
+			name:         "reading is always required",
+			restrictions: APIRestrictions{EnableSpotAndMarginTrading: true},
+			required:     APIPermissions{SpotTrading: true},
+			want:         []string{"reading"},
+		},
+		{
+			name:         "margin needs spot and margin trading",
+			restrictions: APIRestrictions{EnableReading: true, EnableMargin: true},
+			required:     APIPermissions{Margin: true},
+			want:         []string{"margin"},
+		},
+		{
+			name:         "withdrawals without ip restriction",
+			restrictions: APIRestrictions{EnableReading: true, EnableWithdrawals: true, EnableFutures: true},
+			required:     APIPermissions{Futures: true, Withdrawals: true, UniversalTransfer: true, IPRestricted: true},
+			want:         []string{"universal transfer", "ip restriction"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.restrictions.Missing(tt.required); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Missing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckAPIPermissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		restrictions string
+		status       string
+		required     APIPermissions
+		wantErr      bool
+		wantPaths    []string
+	}{
+		{
+			name:         "trading allowed",
+			restrictions: `{"enableReading":true,"enableSpotAndMarginTrading":true}`,
+			status:       `{"data":{"isLocked":false}}`,
+			required:     APIPermissions{SpotTrading: true},
+			wantPaths:    []string{"/sapi/v1/account/apiRestrictions", "/sapi/v1/account/apiTradingStatus"},
+		},
+		{
+			name:         "trading locked",
+			restrictions: `{"enableReading":true,"enableSpotAndMarginTrading":true}`,
+			status:       `{"data":{"isLocked":true,"plannedRecoverTime":1700000000000}}`,
+			required:     APIPermissions{SpotTrading: true},
+			wantErr:      true,
+			wantPaths:    []string{"/sapi/v1/account/apiRestrictions", "/sapi/v1/account/apiTradingStatus"},
+		},
+		{
+			name:         "missing permission",
+			restrictions: `{"enableReading":true}`,
+			required:     APIPermissions{Futures: true},
+			wantErr:      true,
+			wantPaths:    []string{"/sapi/v1/account/apiRestrictions"},
+		},
+		{
+			name:         "trading status not needed",
+			restrictions: `{"enableReading":true,"enableWithdrawals":true,"ipRestrict":true}`,
+			required:     APIPermissions{Withdrawals: true, IPRestricted: true},
+			wantPaths:    []string{"/sapi/v1/account/apiRestrictions"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				switch r.URL.Path {
+				case "/sapi/v1/account/apiRestrictions":
+					_, _ = w.Write([]byte(tt.restrictions))
+				case "/sapi/v1/account/apiTradingStatus":
+					_, _ = w.Write([]byte(tt.status))
+				}
+			})
+			err := bc.CheckAPIPermissions(tt.required)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckAPIPermissions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("paths = %v, want %v", paths, tt.wantPaths)
+			}
+		})
+	}
+}
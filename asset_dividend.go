@@ -0,0 +1,132 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	assetDividendWindow   = 180 * 24 * time.Hour
+	maxAssetDividendLimit = 500
+)
+
+// AssetDividend is an inbound credit which is neither a deposit nor a trade: airdrop, staking reward, distribution...
+type AssetDividend struct {
+	ID      int64           `json:"id"`
+	Amount  decimal.Decimal `json:"amount"`
+	Asset   string          `json:"asset"`
+	DivTime int64           `json:"divTime"`
+	EnInfo  string          `json:"enInfo"`
+	TranID  int64           `json:"tranId"`
+}
+
+// GetAssetDividends return dividends of asset (all assets if empty) and the total number of records, the range is at
+// most 180 days and limit at most 500
+func (bc *Client) GetAssetDividends(asset string, startTime, endTime int64, limit int) ([]AssetDividend, int64, *FwdData, error) {
+	var (
+		result struct {
+			Rows  []AssetDividend `json:"rows"`
+			Total int64           `json:"total"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/assetDividend", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "asset", asset)
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.Rows, result.Total, fwd, err
+}
+
+// AssetDividendIterator page backward in time through dividends of any time range, records are deduplicated by id
+type AssetDividendIterator struct {
+	bc    *Client
+	asset string
+	start int64
+	end   int64
+	seen  map[int64]bool
+	page  []AssetDividend
+	done  bool
+	err   error
+}
+
+// NewAssetDividendIterator create an iterator over dividends of asset (all assets if empty) between startTime and
+// endTime, from the most recent, a zero endTime means now
+func (bc *Client) NewAssetDividendIterator(asset string, startTime, endTime int64) *AssetDividendIterator {
+	if endTime == 0 {
+		endTime = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	return &AssetDividendIterator{
+		bc:    bc,
+		asset: asset,
+		start: startTime,
+		end:   endTime,
+		seen:  make(map[int64]bool),
+		done:  startTime > endTime,
+	}
+}
+
+// Next fetch the next non empty page, it returns false when there is no more dividend or an error occurred
+func (it *AssetDividendIterator) Next() bool {
+	for !it.done {
+		windowStart := it.end - assetDividendWindow.Milliseconds() + 1
+		if windowStart < it.start {
+			windowStart = it.start
+		}
+		rows, _, _, err := it.bc.GetAssetDividends(it.asset, windowStart, it.end, maxAssetDividendLimit)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+		if len(rows) < maxAssetDividendLimit {
+			it.end = windowStart - 1
+		} else {
+			// the window has more records than a page, continue from the oldest record of the page, records at that
+			// time already returned are skipped
+			oldest := it.end
+			for _, r := range rows {
+				if r.DivTime < oldest {
+					oldest = r.DivTime
+				}
+			}
+			if oldest == it.end {
+				// a whole page at the same time can not be paged through without skipping records
+				it.err = fmt.Errorf("more than %d dividends at time %d", maxAssetDividendLimit, oldest)
+				it.done = true
+				return false
+			}
+			it.end = oldest
+		}
+		if it.end < it.start {
+			it.done = true
+		}
+		it.page = nil
+		for _, r := range rows {
+			if it.seen[r.ID] {
+				continue
+			}
+			it.seen[r.ID] = true
+			it.page = append(it.page, r)
+		}
+		if len(it.page) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Page return the dividends fetched by the last call to Next
+func (it *AssetDividendIterator) Page() []AssetDividend {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *AssetDividendIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func TestAssetDividendIterator(t *testing.T) {
+	window := assetDividendWindow.Milliseconds()
+	end := int64(1700000000000)
+	// many is a full page of dividends plus one, the two oldest share the same time
+	var many []AssetDividend
+	for i := 0; i <= maxAssetDividendLimit; i++ {
+		many = append(many, AssetDividend{ID: int64(i + 1), DivTime: end - int64(maxAssetDividendLimit) + int64(i)})
+	}
+	many[0].DivTime = many[1].DivTime
+	// same is a full page of dividends all at the same time
+	var same []AssetDividend
+	for i := 0; i < maxAssetDividendLimit; i++ {
+		same = append(same, AssetDividend{ID: int64(i + 1), DivTime: end})
+	}
+	tests := []struct {
+		name      string
+		start     int64
+		end       int64
+		dividends []AssetDividend
+		wantCalls [][2]int64
+		wantIDs   int // number of distinct dividends expected, newest first
+		wantErr   bool
+	}{
+		{
+			name:      "windows from the most recent",
+			start:     end - window - 9,
+			end:       end,
+			dividends: []AssetDividend{{ID: 1, DivTime: end - window - 9}, {ID: 2, DivTime: end}},
+			wantCalls: [][2]int64{{end - window + 1, end}, {end - window - 9, end - window}},
+			wantIDs:   2,
+		},
+		{
+			name:      "full page continues from the oldest record",
+			start:     end - 1000,
+			end:       end,
+			dividends: many,
+			wantCalls: [][2]int64{{end - 1000, end}, {end - 1000, end - maxAssetDividendLimit + 1}},
+			wantIDs:   len(many),
+		},
+		{
+			name:      "full page at the same time",
+			start:     end - 1000,
+			end:       end,
+			dividends: same,
+			wantCalls: [][2]int64{{end - 1000, end}},
+			wantErr:   true,
+		},
+		{
+			name:  "empty range",
+			start: end,
+			end:   end - 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls [][2]int64
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sapi/v1/asset/assetDividend" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				q := r.URL.Query()
+				start, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+				end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+				limit, _ := strconv.Atoi(q.Get("limit"))
+				calls = append(calls, [2]int64{start, end})
+				rows := []AssetDividend{}
+				for _, d := range tt.dividends {
+					if d.DivTime >= start && d.DivTime <= end {
+						rows = append(rows, d)
+					}
+				}
+				sort.SliceStable(rows, func(i, j int) bool { return rows[i].DivTime > rows[j].DivTime })
+				if len(rows) > limit {
+					rows = rows[:limit]
+				}
+				writeJSON(t, w, map[string]interface{}{"rows": rows, "total": len(rows)})
+			})
+			it := bc.NewAssetDividendIterator("", tt.start, tt.end)
+			var ids []int64
+			seen := make(map[int64]bool)
+			for it.Next() {
+				for _, d := range it.Page() {
+					if seen[d.ID] {
+						t.Errorf("dividend %d returned twice", d.ID)
+					}
+					seen[d.ID] = true
+					ids = append(ids, d.ID)
+				}
+			}
+			if err := it.Err(); (err != nil) != tt.wantErr {
+				t.Fatalf("Err() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(ids) != tt.wantIDs {
+				t.Errorf("%d dividends, want %d", len(ids), tt.wantIDs)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
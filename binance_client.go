@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -19,24 +20,51 @@ type Client struct {
 	secretKey        string
 	apiBaseURL       string // for both spot and margin
 	futureAPIBaseURL string
+	// coinMFutureAPIBaseURL default to COINMAPI, it can be changed with SetCoinMFutureAPIBaseURL
+	coinMFutureAPIBaseURL string
+	dryRun                bool
+	logger                Logger
+}
+
+// Logger is used to report requests skipped in dry-run mode
+type Logger interface {
+	Printf(format string, v ...interface{})
 }
 
 // NewClient create new client object
 func NewClient(key, secret, apiBaseURL, futureAPIBaseURL string, hc *http.Client) *Client {
 	return &Client{
-		apiKey:           key,
-		secretKey:        secret,
-		apiBaseURL:       apiBaseURL,
-		futureAPIBaseURL: futureAPIBaseURL,
-		httpClient:       hc,
+		apiKey:                key,
+		secretKey:             secret,
+		apiBaseURL:            apiBaseURL,
+		futureAPIBaseURL:      futureAPIBaseURL,
+		coinMFutureAPIBaseURL: COINMAPI,
+		httpClient:            hc,
 	}
 }
 
-func (bc *Client) createListenKey(apiPath string) (string, error) {
+// SetCoinMFutureAPIBaseURL change the base url of COIN-M futures requests, e.g to use testnet
+func (bc *Client) SetCoinMFutureAPIBaseURL(coinMFutureAPIBaseURL string) {
+	bc.coinMFutureAPIBaseURL = coinMFutureAPIBaseURL
+}
+
+// SetDryRun enable or disable dry-run mode. In dry-run mode every request that changes account state is logged
+// and either sent to the matching test endpoint or short-circuited with a synthetic response.
+func (bc *Client) SetDryRun(enabled bool, logger Logger) {
+	bc.dryRun = enabled
+	bc.logger = logger
+}
+
+// IsDryRun return true if the client is in dry-run mode
+func (bc *Client) IsDryRun() bool {
+	return bc.dryRun
+}
+
+func (bc *Client) createListenKey(baseURL, apiPath string) (string, error) {
 	var (
 		listenKey ListenKey
 	)
-	requestURL := fmt.Sprintf("%s/%s", bc.apiBaseURL, apiPath)
+	requestURL := fmt.Sprintf("%s/%s", baseURL, apiPath)
 	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
 	if err != nil {
 		return "", err
@@ -50,8 +78,8 @@ func (bc *Client) createListenKey(apiPath string) (string, error) {
 	return listenKey.ListenKey, nil
 }
 
-func (bc *Client) keepListenKeyAlive(listenKey, apiPath string) error {
-	requestURL := fmt.Sprintf("%s/%s", bc.apiBaseURL, apiPath)
+func (bc *Client) keepListenKeyAlive(listenKey, baseURL, apiPath string) error {
+	requestURL := fmt.Sprintf("%s/%s", baseURL, apiPath)
 	req, err := NewRequestBuilder(http.MethodPut, requestURL, nil)
 	if err != nil {
 		return err
@@ -63,6 +91,44 @@ func (bc *Client) keepListenKeyAlive(listenKey, apiPath string) error {
 	return err
 }
 
+func (bc *Client) closeListenKey(listenKey, baseURL, apiPath string) error {
+	requestURL := fmt.Sprintf("%s/%s", baseURL, apiPath)
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("listenKey", listenKey).
+		Request()
+	_, err = bc.doRequest(rr, nil)
+	return err
+}
+
+// doMutatingRequest execute a request that changes account state. In dry-run mode the request is logged,
+// then it is sent to the /test variant of its endpoint if testable, otherwise a synthetic response is returned.
+func (bc *Client) doMutatingRequest(req *http.Request, data interface{}, testable bool) (*FwdData, error) {
+	if !bc.dryRun {
+		return bc.doRequest(req, data)
+	}
+	if bc.logger != nil {
+		bc.logger.Printf("binance dry-run: %s %s %s", req.Method, req.URL.Path, redactSignature(req.URL.Query()).Encode())
+	}
+	if testable {
+		req.URL.Path += "/test"
+		return bc.doRequest(req, nil)
+	}
+	return &FwdData{
+		Status:      http.StatusOK,
+		ContentType: "application/json",
+		Data:        []byte("{}"),
+	}, nil
+}
+
+func redactSignature(params url.Values) url.Values {
+	params.Del("signature")
+	return params
+}
+
 func (bc *Client) doRequest(req *http.Request, data interface{}) (*FwdData, error) {
 	resp, err := bc.httpClient.Do(req)
 	if err != nil {
@@ -0,0 +1,132 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// newTestClient return a client which sends spot and futures requests to handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	bc := NewClient("key", "secret", server.URL, server.URL, server.Client())
+	bc.SetCoinMFutureAPIBaseURL(server.URL)
+	return bc
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, v interface{}) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+// testLogger collect the lines logged in dry-run mode
+type testLogger struct {
+	lines []string
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.lines = append(l.lines, fmt.Sprintf(format, v...))
+}
+
+func TestDryRun(t *testing.T) {
+	tests := []struct {
+		name      string
+		dryRun    bool
+		call      func(bc *Client) (*FwdData, error)
+		wantPaths []string
+		wantLogs  int
+	}{
+		{
+			name:   "live order",
+			dryRun: false,
+			call: func(bc *Client) (*FwdData, error) {
+				_, fwd, err := bc.CreateOrder("BUY", "BTCUSDT", "LIMIT", "GTC", "10000", "1")
+				return fwd, err
+			},
+			wantPaths: []string{"/api/v3/order"},
+		},
+		{
+			name:   "testable order is sent to the test endpoint",
+			dryRun: true,
+			call: func(bc *Client) (*FwdData, error) {
+				result, fwd, err := bc.CreateOrder("BUY", "BTCUSDT", "LIMIT", "GTC", "10000", "1")
+				want := CreateOrderResult{Symbol: "BTCUSDT", Side: "BUY", Type: "LIMIT", TimeInForce: "GTC", Price: "10000", OrigQty: "1"}
+				if result != want {
+					t.Errorf("CreateOrder() = %+v, want %+v", result, want)
+				}
+				return fwd, err
+			},
+			wantPaths: []string{"/api/v3/order/test"},
+			wantLogs:  1,
+		},
+		{
+			name:   "other requests are not sent",
+			dryRun: true,
+			call: func(bc *Client) (*FwdData, error) {
+				_, fwd, err := bc.CancelOrder("BTCUSDT", 1)
+				return fwd, err
+			},
+			wantLogs: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				writeJSON(t, w, map[string]interface{}{"symbol": "BTCUSDT", "orderId": 1})
+			})
+			logger := &testLogger{}
+			bc.SetDryRun(tt.dryRun, logger)
+			fwd, err := tt.call(bc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fwd == nil || fwd.Status != http.StatusOK {
+				t.Errorf("fwd = %+v, want status 200", fwd)
+			}
+			if !reflect.DeepEqual(paths, tt.wantPaths) {
+				t.Errorf("paths = %v, want %v", paths, tt.wantPaths)
+			}
+			if len(logger.lines) != tt.wantLogs {
+				t.Fatalf("logged %v, want %d lines", logger.lines, tt.wantLogs)
+			}
+			for _, line := range logger.lines {
+				if strings.Contains(line, "signature") {
+					t.Errorf("logged line %q contains the signature", line)
+				}
+			}
+		})
+	}
+}
+
+func TestTestOrder(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/order/test" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("computeCommissionRates") != "true" || q.Get("type") != "MARKET" || q.Get("price") != "" {
+			t.Errorf("unexpected params %v", q)
+		}
+		_, _ = w.Write([]byte(`{"standardCommissionForOrder":{"maker":"0.001","taker":"0.002"}}`))
+	})
+	result, _, err := bc.TestOrder("BUY", "BTCUSDT", "MARKET", "", "", "1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.StandardCommissionForOrder.Taker.Equal(decimal.RequireFromString("0.002")) {
+		t.Errorf("taker commission = %s, want 0.002", result.StandardCommissionForOrder.Taker)
+	}
+}
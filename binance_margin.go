@@ -3,9 +3,15 @@ package binance
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+
+	"github.com/shopspring/decimal"
 )
 
+// maxIsolatedMarginAccountSymbols is the max number of symbols per isolated margin account query
+const maxIsolatedMarginAccountSymbols = 5
+
 const (
 	listenKeyTypeMarginAPI         = "sapi/v1/userDataStream"
 	listenKeyTypeIsolatedMarginAPI = "sapi/v1/userDataStream/isolated"
@@ -13,22 +19,22 @@ const (
 
 // CreateListenKeyMargin create a listen key for user data stream
 func (bc *Client) CreateListenKeyMargin() (string, error) {
-	return bc.createListenKey(listenKeyTypeMarginAPI)
+	return bc.createListenKey(bc.apiBaseURL, listenKeyTypeMarginAPI)
 }
 
 // KeepListenKeyAliveMargin keep it alive
 func (bc *Client) KeepListenKeyAliveMargin(listenKey string) error {
-	return bc.keepListenKeyAlive(listenKey, listenKeyTypeMarginAPI)
+	return bc.keepListenKeyAlive(listenKey, bc.apiBaseURL, listenKeyTypeMarginAPI)
 }
 
 // CreateListenKeyIsolatedMargin create a listen key for user data stream
 func (bc *Client) CreateListenKeyIsolatedMargin() (string, error) {
-	return bc.createListenKey(listenKeyTypeIsolatedMarginAPI)
+	return bc.createListenKey(bc.apiBaseURL, listenKeyTypeIsolatedMarginAPI)
 }
 
 // KeepListenKeyAliveIsolatedMargin keep it alive
 func (bc *Client) KeepListenKeyAliveIsolatedMargin(listenKey string) error {
-	return bc.keepListenKeyAlive(listenKey, listenKeyTypeIsolatedMarginAPI)
+	return bc.keepListenKeyAlive(listenKey, bc.apiBaseURL, listenKeyTypeIsolatedMarginAPI)
 }
 
 type marginCommonResult struct {
@@ -54,7 +60,7 @@ func (bc *Client) TransferCrossMargin(asset, amount string, spotToMargin bool) (
 		WithParam("amount", amount).
 		WithParam("type", transType).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	if err != nil {
 		return 0, fwd, err
 	}
@@ -80,7 +86,7 @@ func (bc *Client) Borrow(asset, symbol, amount string, isIsolated bool) (uint64,
 			WithParam("symbol", symbol)
 	}
 	sr := rr.SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(sr, &result)
+	fwd, err := bc.doMutatingRequest(sr, &result, false)
 	if err != nil {
 		return 0, fwd, err
 	}
@@ -105,7 +111,7 @@ func (bc *Client) Repay(asset, symbol, amount string, isIsolated bool) (uint64,
 			WithParam("symbol", symbol)
 	}
 	sr := rr.SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(sr, &result)
+	fwd, err := bc.doMutatingRequest(sr, &result, false)
 	if err != nil {
 		return 0, fwd, err
 	}
@@ -130,10 +136,10 @@ func (bc *Client) GetMarginAsset(asset string) (MarginAsset, *FwdData, error) {
 	return result, fwd, err
 }
 
-// GetMarginPair return pair info
-func (bc *Client) GetMarginPair(symbol string) (MarginAsset, *FwdData, error) {
+// GetMarginPair return cross margin pair info
+func (bc *Client) GetMarginPair(symbol string) (MarginPair, *FwdData, error) {
 	var (
-		result MarginAsset
+		result MarginPair
 	)
 	requestURL := fmt.Sprintf("%s/sapi/v1/margin/pair", bc.apiBaseURL)
 	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
@@ -222,7 +228,7 @@ func (bc *Client) TransferIsolatedMargin(asset, symbol, amount string, transFrom
 		WithParam("transTo", transferTo.String()).
 		WithParam("amount", amount).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	if err != nil {
 		return 0, fwd, err
 	}
@@ -234,8 +240,8 @@ func (bc *Client) GetIsolatedMarginAccountDetails(symbols []string) (IsolatedMar
 	var (
 		result IsolatedMarginAccountDetails
 	)
-	if len(symbols) > 5 {
-		return result, nil, fmt.Errorf("the api only supports max 5 symbols")
+	if len(symbols) > maxIsolatedMarginAccountSymbols {
+		return result, nil, fmt.Errorf("the api only supports max %d symbols", maxIsolatedMarginAccountSymbols)
 	}
 	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolated/account", bc.apiBaseURL)
 	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
@@ -252,3 +258,208 @@ func (bc *Client) GetIsolatedMarginAccountDetails(symbols []string) (IsolatedMar
 	}
 	return result, fwd, err
 }
+
+// GetIsolatedMarginAccountDetailsAll return isolated account details of any number of symbols, the symbols are queried
+// by batches of 5 and merged, totals are summed over batches
+func (bc *Client) GetIsolatedMarginAccountDetailsAll(symbols []string) (IsolatedMarginAccountDetails, error) {
+	if len(symbols) <= maxIsolatedMarginAccountSymbols {
+		result, _, err := bc.GetIsolatedMarginAccountDetails(symbols)
+		return result, err
+	}
+	var (
+		result                                                   IsolatedMarginAccountDetails
+		totalAssetOfBtc, totalLiabilityOfBtc, totalNetAssetOfBtc decimal.Decimal
+	)
+	for start := 0; start < len(symbols); start += maxIsolatedMarginAccountSymbols {
+		end := start + maxIsolatedMarginAccountSymbols
+		if end > len(symbols) {
+			end = len(symbols)
+		}
+		batch, _, err := bc.GetIsolatedMarginAccountDetails(symbols[start:end])
+		if err != nil {
+			return IsolatedMarginAccountDetails{}, fmt.Errorf("failed to get isolated account of %v, %w", symbols[start:end], err)
+		}
+		result.Assets = append(result.Assets, batch.Assets...)
+		for _, v := range []struct {
+			total *decimal.Decimal
+			value string
+		}{
+			{&totalAssetOfBtc, batch.TotalAssetOfBtc},
+			{&totalLiabilityOfBtc, batch.TotalLiabilityOfBtc},
+			{&totalNetAssetOfBtc, batch.TotalNetAssetOfBtc},
+		} {
+			if v.value == "" {
+				continue
+			}
+			d, err := decimal.NewFromString(v.value)
+			if err != nil {
+				return IsolatedMarginAccountDetails{}, fmt.Errorf("invalid isolated account total %q, %w", v.value, err)
+			}
+			*v.total = v.total.Add(d)
+		}
+	}
+	result.TotalAssetOfBtc = totalAssetOfBtc.String()
+	result.TotalLiabilityOfBtc = totalLiabilityOfBtc.String()
+	result.TotalNetAssetOfBtc = totalNetAssetOfBtc.String()
+	return result, nil
+}
+
+// GetAllMarginPairs return all cross margin pairs
+func (bc *Client) GetAllMarginPairs() ([]MarginPair, *FwdData, error) {
+	var (
+		result []MarginPair
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/allPairs", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).Request()
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetIsolatedMarginPair return isolated margin pair info
+func (bc *Client) GetIsolatedMarginPair(symbol string) (MarginPair, *FwdData, error) {
+	var (
+		result MarginPair
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolated/pair", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetAllIsolatedMarginPairs return all isolated margin pairs
+func (bc *Client) GetAllIsolatedMarginPairs() ([]MarginPair, *FwdData, error) {
+	var (
+		result []MarginPair
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolated/allPairs", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// EnableIsolatedMarginAccount enable the isolated margin account of symbol
+func (bc *Client) EnableIsolatedMarginAccount(symbol string) (IsolatedMarginAccountResult, *FwdData, error) {
+	return bc.setIsolatedMarginAccount(http.MethodPost, symbol)
+}
+
+// DisableIsolatedMarginAccount disable the isolated margin account of symbol, it must have no balance nor debt
+func (bc *Client) DisableIsolatedMarginAccount(symbol string) (IsolatedMarginAccountResult, *FwdData, error) {
+	return bc.setIsolatedMarginAccount(http.MethodDelete, symbol)
+}
+
+func (bc *Client) setIsolatedMarginAccount(method, symbol string) (IsolatedMarginAccountResult, *FwdData, error) {
+	var (
+		result IsolatedMarginAccountResult
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolated/account", bc.apiBaseURL)
+	req, err := NewRequestBuilder(method, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// GetIsolatedMarginAccountLimit return the number of enabled isolated margin accounts and the max allowed
+func (bc *Client) GetIsolatedMarginAccountLimit() (IsolatedMarginAccountLimit, *FwdData, error) {
+	var (
+		result IsolatedMarginAccountLimit
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolated/accountLimit", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetMaxTransferable return the max amount of asset which can be transferred out of the margin account
+func (bc *Client) GetMaxTransferable(asset, isolatedSymbol string) (MaxTransferableResult, *FwdData, error) {
+	var (
+		result MaxTransferableResult
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/maxTransferable", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("asset", asset)
+	rr = withOptionalParam(rr, "isolatedSymbol", isolatedSymbol)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetCrossMarginData return cross margin interest and borrow limit, vipLevel < 0 means the account's own level and an
+// empty coin means all coins
+func (bc *Client) GetCrossMarginData(vipLevel int, coin string) ([]CrossMarginData, *FwdData, error) {
+	var (
+		result []CrossMarginData
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/crossMarginData", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "coin", coin)
+	if vipLevel >= 0 {
+		rr = rr.WithParam("vipLevel", strconv.Itoa(vipLevel))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetIsolatedMarginData return isolated margin interest and borrow limit, vipLevel < 0 means the account's own level
+// and an empty symbol means all symbols
+func (bc *Client) GetIsolatedMarginData(vipLevel int, symbol string) ([]IsolatedMarginData, *FwdData, error) {
+	var (
+		result []IsolatedMarginData
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/isolatedMarginData", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "symbol", symbol)
+	if vipLevel >= 0 {
+		rr = rr.WithParam("vipLevel", strconv.Itoa(vipLevel))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetMarginPriceIndex return the margin price index of symbol
+func (bc *Client) GetMarginPriceIndex(symbol string) (MarginPriceIndex, *FwdData, error) {
+	var (
+		result MarginPriceIndex
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/priceIndex", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		Request()
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
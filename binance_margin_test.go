@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetMarginPair(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sapi/v1/margin/pair" || r.URL.Query().Get("symbol") != "BNBBTC" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		_, _ = w.Write([]byte(`{"id":323355778339572400,"symbol":"BNBBTC","base":"BNB","quote":"BTC",
+			"isMarginTrade":true,"isBuyAllowed":true,"isSellAllowed":false,"delistTime":1700000000000}`))
+	})
+	pair, _, err := bc.GetMarginPair("BNBBTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MarginPair{
+		ID:            323355778339572400,
+		Symbol:        "BNBBTC",
+		Base:          "BNB",
+		Quote:         "BTC",
+		IsMarginTrade: true,
+		IsBuyAllowed:  true,
+		DelistTime:    1700000000000,
+	}
+	if pair != want {
+		t.Errorf("GetMarginPair() = %+v, want %+v", pair, want)
+	}
+}
+
+func TestGetIsolatedMarginAccountDetailsAll(t *testing.T) {
+	symbols := []string{"S1", "S2", "S3", "S4", "S5", "S6", "S7"}
+	var batches []string
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requested := r.URL.Query().Get("symbols")
+		batches = append(batches, requested)
+		var assets []map[string]interface{}
+		for _, s := range strings.Split(requested, ",") {
+			assets = append(assets, map[string]interface{}{"symbol": s})
+		}
+		writeJSON(t, w, map[string]interface{}{
+			"assets":              assets,
+			"totalAssetOfBtc":     "1.5",
+			"totalLiabilityOfBtc": "0.5",
+			"totalNetAssetOfBtc":  "1",
+		})
+	})
+	details, err := bc.GetIsolatedMarginAccountDetailsAll(symbols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"S1,S2,S3,S4,S5", "S6,S7"}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("batches = %v, want %v", batches, want)
+	}
+	var got []string
+	for _, a := range details.Assets {
+		got = append(got, a.Symbol)
+	}
+	if !reflect.DeepEqual(got, symbols) {
+		t.Errorf("assets = %v, want %v", got, symbols)
+	}
+	if details.TotalAssetOfBtc != "3" || details.TotalLiabilityOfBtc != "1" || details.TotalNetAssetOfBtc != "2" {
+		t.Errorf("totals = %s, %s, %s, want 3, 1, 2", details.TotalAssetOfBtc, details.TotalLiabilityOfBtc, details.TotalNetAssetOfBtc)
+	}
+}
+
+func TestGetIsolatedMarginData(t *testing.T) {
+	tests := []struct {
+		name       string
+		vipLevel   int
+		symbol     string
+		wantParams map[string]string
+	}{
+		{name: "own vip level and all symbols", vipLevel: -1, wantParams: map[string]string{}},
+		{name: "vip 0 and a symbol", vipLevel: 0, symbol: "BTCUSDT", wantParams: map[string]string{"vipLevel": "0", "symbol": "BTCUSDT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sapi/v1/margin/isolatedMarginData" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if got := signedParams(r); !reflect.DeepEqual(got, tt.wantParams) {
+					t.Errorf("params = %v, want %v", got, tt.wantParams)
+				}
+				_, _ = w.Write([]byte(`[{"vipLevel":0,"symbol":"BTCUSDT","leverage":"10",
+					"data":[{"coin":"BTC","dailyInterest":"0.00026125","borrowLimit":"270"}]}]`))
+			})
+			data, _, err := bc.GetIsolatedMarginData(tt.vipLevel, tt.symbol)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != 1 || data[0].Leverage != 10 || len(data[0].Data) != 1 || data[0].Data[0].Coin != "BTC" {
+				t.Errorf("GetIsolatedMarginData() = %+v", data)
+			}
+		})
+	}
+}
@@ -19,12 +19,12 @@ const (
 
 // CreateListenKeySpot create a listen key for user data stream
 func (bc *Client) CreateListenKeySpot() (string, error) {
-	return bc.createListenKey(listenKeySpotAPI)
+	return bc.createListenKey(bc.apiBaseURL, listenKeySpotAPI)
 }
 
 // KeepListenKeyAliveSpot keep it alive
 func (bc *Client) KeepListenKeyAliveSpot(listenKey string) error {
-	return bc.keepListenKeyAlive(listenKey, listenKeySpotAPI)
+	return bc.keepListenKeyAlive(listenKey, bc.apiBaseURL, listenKeySpotAPI)
 }
 
 // GetAccountState return account info
@@ -60,7 +60,48 @@ func (bc *Client) CreateOrder(side, symbol, ordType, timeInForce, price, quantit
 		WithParam("quantity", quantity).
 		WithParam("price", price).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &response)
+	fwd, err := bc.doMutatingRequest(rr, &response, true)
+	if err == nil && bc.dryRun {
+		response = CreateOrderResult{
+			Symbol:      symbol,
+			Side:        side,
+			Type:        ordType,
+			TimeInForce: timeInForce,
+			Price:       price,
+			OrigQty:     quantity,
+		}
+	}
+	return response, fwd, err
+}
+
+// TestOrder validate a new order without sending it to the matching engine,
+// if computeCommissionRates is true, the commission rates of the order are returned
+func (bc *Client) TestOrder(side, symbol, ordType, timeInForce, price, quantity string, computeCommissionRates bool) (TestOrderResult, *FwdData, error) {
+	var (
+		response TestOrderResult
+	)
+	requestURL := fmt.Sprintf("%s/api/v3/order/test", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return response, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		WithParam("side", side).
+		WithParam("type", ordType)
+	if timeInForce != "" {
+		rr = rr.WithParam("timeInForce", timeInForce)
+	}
+	if quantity != "" {
+		rr = rr.WithParam("quantity", quantity)
+	}
+	if price != "" {
+		rr = rr.WithParam("price", price)
+	}
+	if computeCommissionRates {
+		rr = rr.WithParam("computeCommissionRates", "true")
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &response)
 	return response, fwd, err
 }
 
@@ -99,6 +140,46 @@ func (bc *Client) OrderStatus(symbol string, id int64) (*OpenOrder, *FwdData, er
 	return &result, fwd, err
 }
 
+// GetOrderRateLimit return the current unfilled order count for all order rate limit intervals
+func (bc *Client) GetOrderRateLimit() ([]OrderRateLimit, *FwdData, error) {
+	var result []OrderRateLimit
+	requestURL := fmt.Sprintf("%s/api/v3/rateLimit/order", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetPreventedMatches return orders expired because of self trade prevention, either preventedMatchID or orderID
+// must be set, fromPreventedMatchID and limit are only used together with orderID
+func (bc *Client) GetPreventedMatches(symbol string, preventedMatchID, orderID, fromPreventedMatchID int64, limit int) ([]PreventedMatch, *FwdData, error) {
+	var result []PreventedMatch
+	requestURL := fmt.Sprintf("%s/api/v3/myPreventedMatches", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol)
+	if preventedMatchID != 0 {
+		rr = rr.WithParam("preventedMatchId", strconv.FormatInt(preventedMatchID, 10))
+	}
+	if orderID != 0 {
+		rr = rr.WithParam("orderId", strconv.FormatInt(orderID, 10))
+	}
+	if fromPreventedMatchID != 0 {
+		rr = rr.WithParam("fromPreventedMatchId", strconv.FormatInt(fromPreventedMatchID, 10))
+	}
+	if limit > 0 {
+		rr = rr.WithParam("limit", strconv.Itoa(limit))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
 // GetTradeHistory query recent trade list
 func (bc *Client) GetTradeHistory(symbol string, limit int64) (TradeHistoryList, *FwdData, error) {
 	result := TradeHistoryList{}
@@ -146,6 +227,8 @@ func (bc *Client) GetAccountTradeHistory(symbol, startTime, endTime string, limi
 }
 
 // WithdrawHistory query recent withdraw list
+//
+// Deprecated: use GetWithdrawHistory.
 func (bc *Client) WithdrawHistory(coin, startTime, endTime, status string) (WithdrawalsList, *FwdData, error) {
 	result := WithdrawalsList{}
 	requestURL := fmt.Sprintf("%s/sapi/v1/capital/withdraw/history", bc.apiBaseURL)
@@ -174,7 +257,9 @@ func (bc *Client) WithdrawHistory(coin, startTime, endTime, status string) (With
 	return result, fwd, err
 }
 
-// DepositHistory query recent withdraw list
+// DepositHistory query recent deposit list
+//
+// Deprecated: use GetDepositHistory.
 func (bc *Client) DepositHistory(coin, status, startTime, endTime string) (DepositsList, *FwdData, error) {
 	result := DepositsList{}
 	requestURL := fmt.Sprintf("%s/sapi/v1/capital/deposit/hisrec", bc.apiBaseURL)
@@ -215,7 +300,7 @@ func (bc *Client) CancelOrder(symbol string, id int64) (CancelResult, *FwdData,
 		WithParam("symbol", symbol).
 		WithParam("orderId", strconv.FormatInt(id, 10)).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	return result, fwd, err
 }
 
@@ -230,31 +315,26 @@ func (bc *Client) CancelAllOrder(symbol string) ([]BOrder, *FwdData, error) {
 	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
 		WithParam("symbol", symbol).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	return result, fwd, err
 }
 
-// Withdraw ...
+// Withdraw submit a withdrawal, empty name and orderID are not sent.
+//
+// Deprecated: use CreateWithdraw which supports all params and validates them against the coin info.
 func (bc *Client) Withdraw(coin, amount, address, network, name, orderID string) (string, *FwdData, error) {
-	var result WithdrawResult
-	requestURL := fmt.Sprintf("%s/sapi/v1/capital/withdraw/apply", bc.apiBaseURL)
-	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
-	if err != nil {
-		return "", nil, err
-	}
-	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
-		WithParam("coin", coin).
-		WithParam("withdrawOrderId", orderID).
-		WithParam("network", network).
-		WithParam("address", address).
-		WithParam("name", name).
-		WithParam("amount", amount).
-		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
-	if err != nil {
-		return "", fwd, err
-	}
-	return result.ID, fwd, err
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid withdrawal amount %q, %w", amount, err)
+	}
+	return bc.withdraw(WithdrawRequest{
+		Coin:            coin,
+		Network:         network,
+		Address:         address,
+		Amount:          value,
+		WithdrawOrderID: orderID,
+		Name:            name,
+	})
 }
 
 // TransferToMainAccount withdraw from sub account to main account
@@ -271,7 +351,7 @@ func (bc *Client) TransferToMainAccount(asset, amount string) (int64, *FwdData,
 		WithParam("asset", asset).
 		WithParam("amount", amount).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	if err != nil {
 		return 0, fwd, err
 	}
@@ -348,11 +428,11 @@ func (bc *Client) AssetTransfer(fromEmail, fromAccType, toEmail, toAccountType,
 		WithParam("fromAccountType", fromAccType).
 		WithParam("toAccountType", toAccountType).
 		SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
 	if err != nil {
 		return result, fwd, err
 	}
-	if !result.Success && fwd != nil {
+	if !result.Success && !bc.dryRun && fwd != nil {
 		return result, fwd, fmt.Errorf("binance failure: %s", string(fwd.Data))
 	}
 	return result, fwd, err
@@ -549,18 +629,7 @@ type FundingWalletBalance struct {
 	BtcValuation decimal.Decimal `json:"btcValuation"`
 }
 
+// GetFundingWallet return all funding wallet balances
 func (bc *Client) GetFundingWallet() ([]FundingWalletBalance, *FwdData, error) {
-	var result []FundingWalletBalance
-	requestURL := fmt.Sprintf("%s/sapi/v1/asset/get-funding-asset", bc.apiBaseURL)
-	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
-	if err != nil {
-		return result, nil, err
-	}
-
-	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
-	fwd, err := bc.doRequest(rr, &result)
-	if err != nil {
-		return result, fwd, err
-	}
-	return result, fwd, err
+	return bc.GetFundingWalletAssets("", false)
 }
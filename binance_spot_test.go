@@ -0,0 +1,81 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetOrderRateLimit(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/rateLimit/order" || r.URL.Query().Get("signature") == "" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		writeJSON(t, w, []map[string]interface{}{
+			{"rateLimitType": "ORDERS", "interval": "SECOND", "intervalNum": 10, "limit": 50, "count": 3},
+			{"rateLimitType": "ORDERS", "interval": "DAY", "intervalNum": 1, "limit": 160000, "count": 42},
+		})
+	})
+	limits, _, err := bc.GetOrderRateLimit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(limits) != 2 || limits[0].Interval != "SECOND" || limits[0].Count != 3 || limits[1].Limit != 160000 {
+		t.Fatalf("limits = %+v", limits)
+	}
+}
+
+func TestGetPreventedMatches(t *testing.T) {
+	tests := []struct {
+		name                 string
+		preventedMatchID     int64
+		orderID              int64
+		fromPreventedMatchID int64
+		limit                int
+		wantParams           map[string]string
+	}{
+		{
+			name:             "by prevented match id",
+			preventedMatchID: 7,
+			wantParams:       map[string]string{"symbol": "BTCUSDT", "preventedMatchId": "7"},
+		},
+		{
+			name:                 "by order id with paging",
+			orderID:              42,
+			fromPreventedMatchID: 3,
+			limit:                100,
+			wantParams: map[string]string{"symbol": "BTCUSDT", "orderId": "42", "fromPreventedMatchId": "3",
+				"limit": "100"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v3/myPreventedMatches" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				q := r.URL.Query()
+				for _, key := range []string{"preventedMatchId", "orderId", "fromPreventedMatchId", "limit"} {
+					if _, ok := tt.wantParams[key]; !ok && q.Get(key) != "" {
+						t.Errorf("unexpected %s = %s", key, q.Get(key))
+					}
+				}
+				for key, want := range tt.wantParams {
+					if q.Get(key) != want {
+						t.Errorf("%s = %q, want %q", key, q.Get(key), want)
+					}
+				}
+				writeJSON(t, w, []map[string]interface{}{{
+					"symbol": "BTCUSDT", "preventedMatchId": 7, "takerOrderId": 5, "makerOrderId": 42,
+					"selfTradePreventionMode": "EXPIRE_MAKER", "price": "1.100000", "makerPreventedQuantity": "1.300000",
+				}})
+			})
+			matches, _, err := bc.GetPreventedMatches("BTCUSDT", tt.preventedMatchID, tt.orderID, tt.fromPreventedMatchID, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(matches) != 1 || matches[0].MakerOrderID != 42 || matches[0].MakerPreventedQuantity.String() != "1.3" {
+				t.Fatalf("matches = %+v", matches)
+			}
+		})
+	}
+}
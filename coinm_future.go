@@ -0,0 +1,142 @@
+package binance
+
+import (
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
+
+// CoinMFutureAccount is the COIN-M futures account information
+type CoinMFutureAccount struct {
+	FeeTier     int                     `json:"feeTier"`
+	CanTrade    bool                    `json:"canTrade"`
+	CanDeposit  bool                    `json:"canDeposit"`
+	CanWithdraw bool                    `json:"canWithdraw"`
+	UpdateTime  int64                   `json:"updateTime"`
+	Assets      []FutureAccountAsset    `json:"assets"`
+	Positions   []FutureAccountPosition `json:"positions"`
+}
+
+// GetCoinMFutureAccount return the COIN-M futures account with per asset and per position details
+func (bc *Client) GetCoinMFutureAccount() (CoinMFutureAccount, *FwdData, error) {
+	var (
+		result CoinMFutureAccount
+	)
+	req, err := NewRequestBuilder(http.MethodGet, bc.coinMFutureAPI().url("v1/account"), nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetCoinMPositionInformation return COIN-M positions, marginAsset and pair are optional filters
+func (bc *Client) GetCoinMPositionInformation(marginAsset, pair string) ([]PositionInformation, *FwdData, error) {
+	var (
+		result []PositionInformation
+	)
+	req, err := NewRequestBuilder(http.MethodGet, bc.coinMFutureAPI().url("v1/positionRisk"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey)
+	if marginAsset != "" {
+		rr = rr.WithParam("marginAsset", marginAsset)
+	}
+	if pair != "" {
+		rr = rr.WithParam("pair", pair)
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// AddCoinMFuturePositionMargin add margin to an isolated COIN-M position, positionSide can be empty in one-way mode
+func (bc *Client) AddCoinMFuturePositionMargin(symbol, positionSide string, amount decimal.Decimal) (FuturePositionMarginResult, *FwdData, error) {
+	return bc.modifyFuturePositionMargin(bc.coinMFutureAPI(), symbol, positionSide, amount, "1")
+}
+
+// ReduceCoinMFuturePositionMargin remove margin from an isolated COIN-M position, positionSide can be empty in one-way mode
+func (bc *Client) ReduceCoinMFuturePositionMargin(symbol, positionSide string, amount decimal.Decimal) (FuturePositionMarginResult, *FwdData, error) {
+	return bc.modifyFuturePositionMargin(bc.coinMFutureAPI(), symbol, positionSide, amount, "2")
+}
+
+// NewCoinMFutureIncomeIterator create an iterator over the COIN-M income history matching q, see NewFutureIncomeIterator
+func (bc *Client) NewCoinMFutureIncomeIterator(q FutureIncomeQuery) *FutureIncomeIterator {
+	return newFutureIncomeIterator(bc.GetCoinMFutureIncome, q)
+}
+
+// PlaceCoinMFutureOrder place a new COIN-M futures order
+func (bc *Client) PlaceCoinMFutureOrder(r FutureOrderRequest) (FutureOrder, *FwdData, error) {
+	return bc.placeFutureOrder(bc.coinMFutureAPI(), r)
+}
+
+// PlaceCoinMFutureBatchOrders place up to 5 COIN-M futures orders in one request, the result items have the same order as the requests
+func (bc *Client) PlaceCoinMFutureBatchOrders(orders []FutureOrderRequest) ([]FutureBatchOrderResult, *FwdData, error) {
+	return bc.placeFutureBatchOrders(bc.coinMFutureAPI(), orders)
+}
+
+// ModifyCoinMFutureOrder modify the price and quantity of an open COIN-M limit order
+func (bc *Client) ModifyCoinMFutureOrder(r FutureModifyOrderRequest) (FutureOrder, *FwdData, error) {
+	return bc.modifyFutureOrder(bc.coinMFutureAPI(), r)
+}
+
+// CancelCoinMFutureOrder cancel a COIN-M futures order by orderID or origClientOrderID
+func (bc *Client) CancelCoinMFutureOrder(symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	return bc.cancelFutureOrder(bc.coinMFutureAPI(), symbol, orderID, origClientOrderID)
+}
+
+// CancelAllCoinMFutureOrders cancel all open COIN-M futures orders of a symbol
+func (bc *Client) CancelAllCoinMFutureOrders(symbol string) (CancelAllFutureOrdersResult, *FwdData, error) {
+	return bc.cancelAllFutureOrders(bc.coinMFutureAPI(), symbol)
+}
+
+// CancelCoinMFutureBatchOrders cancel up to 10 COIN-M futures orders of a symbol, either orderIDs or origClientOrderIDs must be set
+func (bc *Client) CancelCoinMFutureBatchOrders(symbol string, orderIDs []int64, origClientOrderIDs []string) ([]FutureBatchOrderResult, *FwdData, error) {
+	return bc.cancelFutureBatchOrders(bc.coinMFutureAPI(), symbol, orderIDs, origClientOrderIDs)
+}
+
+// GetCoinMFutureOrder query a COIN-M futures order by orderID or origClientOrderID
+func (bc *Client) GetCoinMFutureOrder(symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	return bc.getFutureOrder(bc.coinMFutureAPI(), symbol, orderID, origClientOrderID)
+}
+
+// GetCoinMFutureOpenOrders return open COIN-M futures orders, if symbol is empty, open orders of all symbols will return
+func (bc *Client) GetCoinMFutureOpenOrders(symbol string) ([]FutureOrder, *FwdData, error) {
+	return bc.getFutureOpenOrders(bc.coinMFutureAPI(), symbol)
+}
+
+// GetCoinMFutureAllOrders return all COIN-M futures orders of a symbol, the zero value of filters are not sent
+func (bc *Client) GetCoinMFutureAllOrders(symbol string, orderID, startTime, endTime int64, limit int) ([]FutureOrder, *FwdData, error) {
+	return bc.getFutureAllOrders(bc.coinMFutureAPI(), symbol, orderID, startTime, endTime, limit)
+}
+
+// ChangeCoinMFutureLeverage change the initial leverage of a COIN-M symbol
+func (bc *Client) ChangeCoinMFutureLeverage(symbol string, leverage int) (FutureLeverageResult, *FwdData, error) {
+	return bc.changeFutureLeverage(bc.coinMFutureAPI(), symbol, leverage)
+}
+
+// ChangeCoinMFutureMarginType change the margin type of a COIN-M symbol, it is not an error if the symbol already uses marginType
+func (bc *Client) ChangeCoinMFutureMarginType(symbol string, marginType FutureMarginType) (FutureSettingResult, *FwdData, error) {
+	return bc.changeFutureMarginType(bc.coinMFutureAPI(), symbol, marginType)
+}
+
+// GetCoinMFuturePositionMode return true if the COIN-M account uses hedge mode (dual side position)
+func (bc *Client) GetCoinMFuturePositionMode() (bool, *FwdData, error) {
+	return bc.getFuturePositionMode(bc.coinMFutureAPI())
+}
+
+// ChangeCoinMFuturePositionMode switch the COIN-M account between hedge mode (dualSide = true) and one-way mode, it is not an error if the account already uses the requested mode
+func (bc *Client) ChangeCoinMFuturePositionMode(dualSide bool) (FutureSettingResult, *FwdData, error) {
+	return bc.changeFuturePositionMode(bc.coinMFutureAPI(), dualSide)
+}
+
+// GetCoinMFutureIncome return the COIN-M income history, records are sorted by time ascending when StartTime is set
+func (bc *Client) GetCoinMFutureIncome(q FutureIncomeQuery) ([]FutureIncome, *FwdData, error) {
+	return bc.getFutureIncome(bc.coinMFutureAPI(), q)
+}
+
+// GetCoinMFutureUserTrades return the COIN-M account trades of a symbol, the zero value of filters are not sent
+func (bc *Client) GetCoinMFutureUserTrades(symbol string, startTime, endTime, fromID int64, limit int) ([]FutureTrade, *FwdData, error) {
+	return bc.getFutureUserTrades(bc.coinMFutureAPI(), symbol, startTime, endTime, fromID, limit)
+}
@@ -0,0 +1,108 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCoinMFutureRouting(t *testing.T) {
+	order := FutureOrderRequest{Symbol: "BTCUSD_PERP", Side: "BUY", Type: "MARKET", Quantity: decimal.NewFromInt(1)}
+	tests := []struct {
+		name      string
+		dryRun    bool
+		call      func(bc *Client) error
+		wantCalls []string
+	}{
+		{
+			name: "COIN-M account",
+			call: func(bc *Client) error {
+				_, _, err := bc.GetCoinMFutureAccount()
+				return err
+			},
+			wantCalls: []string{"coinm /dapi/v1/account"},
+		},
+		{
+			name: "COIN-M order",
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceCoinMFutureOrder(order)
+				return err
+			},
+			wantCalls: []string{"coinm /dapi/v1/order"},
+		},
+		{
+			name: "COIN-M cancel all",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelAllCoinMFutureOrders("BTCUSD_PERP")
+				return err
+			},
+			wantCalls: []string{"coinm /dapi/v1/allOpenOrders"},
+		},
+		{
+			name: "COIN-M leverage",
+			call: func(bc *Client) error {
+				_, _, err := bc.ChangeCoinMFutureLeverage("BTCUSD_PERP", 5)
+				return err
+			},
+			wantCalls: []string{"coinm /dapi/v1/leverage"},
+		},
+		{
+			name: "USD-M order",
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceFutureOrder(order)
+				return err
+			},
+			wantCalls: []string{"usdm /fapi/v1/order"},
+		},
+		{
+			name:   "USD-M order in dry-run uses the test endpoint",
+			dryRun: true,
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceFutureOrder(order)
+				return err
+			},
+			wantCalls: []string{"usdm /fapi/v1/order/test"},
+		},
+		{
+			name:   "COIN-M order in dry-run is not sent",
+			dryRun: true,
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceCoinMFutureOrder(order)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			server := func(name string) *httptest.Server {
+				s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					calls = append(calls, name+" "+r.URL.Path)
+					_, _ = w.Write([]byte(`{}`))
+				}))
+				t.Cleanup(s.Close)
+				return s
+			}
+			usdm, coinm := server("usdm"), server("coinm")
+			bc := NewClient("key", "secret", "", usdm.URL, usdm.Client())
+			bc.SetCoinMFutureAPIBaseURL(coinm.URL)
+			bc.SetDryRun(tt.dryRun, nil)
+			if err := tt.call(bc); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestNewClientCoinMFutureAPIBaseURL(t *testing.T) {
+	bc := NewClient("key", "secret", "", "", nil)
+	if got := bc.coinMFutureAPI().url("v1/account"); got != COINMAPI+"/dapi/v1/account" {
+		t.Errorf("default COIN-M url = %s, want %s/dapi/v1/account", got, COINMAPI)
+	}
+}
@@ -21,6 +21,11 @@ type WalletType int
 const (
 	SpotWallet           WalletType = iota + 1 // SPOT
 	IsolatedMarginWallet                       // ISOLATED_MARGIN
+	FundingWallet                              // FUNDING
+	USDMFutureWallet                           // UMFUTURE
+	CoinMFutureWallet                          // CMFUTURE
+	CrossMarginWallet                          // MARGIN
+	OptionWallet                               // OPTION
 )
 
 // FwdData contain data we forward to client
@@ -141,28 +146,60 @@ type ExecutionReport struct {
 	QuoteOrderQty                          string `json:"Q"`
 	CumulativeQuoteAssetTransactedQuantity string `json:"Z"`
 	IsOrderInTheBook                       bool   `json:"w"`
+	SelfTradePreventionMode                string `json:"V"`
+	TradeGroupID                           int64  `json:"u"`
+	PreventedMatchID                       int64  `json:"v"`
+	CounterOrderID                         int64  `json:"U"`
+	PreventedQuantity                      string `json:"A"`
+	LastPreventedQuantity                  string `json:"B"`
 }
 
 // OpenOrder ...
 type OpenOrder struct {
-	Symbol              string `json:"symbol"`
-	OrderID             int64  `json:"orderId"`
-	OrderListID         int64  `json:"orderListId"`
-	ClientOrderID       string `json:"clientOrderId"`
-	Price               string `json:"price"`
-	OrigQty             string `json:"origQty"`
-	ExecutedQty         string `json:"executedQty"`
-	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
-	Status              string `json:"status"`
-	TimeInForce         string `json:"timeInForce"`
-	Type                string `json:"type"`
-	Side                string `json:"side"`
-	StopPrice           string `json:"stopPrice"`
-	IcebergQty          string `json:"icebergQty"`
-	Time                int64  `json:"time"`
-	UpdateTime          int64  `json:"updateTime"`
-	IsWorking           bool   `json:"isWorking"`
-	OrigQuoteOrderQty   string `json:"origQuoteOrderQty"`
+	Symbol                  string `json:"symbol"`
+	OrderID                 int64  `json:"orderId"`
+	OrderListID             int64  `json:"orderListId"`
+	ClientOrderID           string `json:"clientOrderId"`
+	Price                   string `json:"price"`
+	OrigQty                 string `json:"origQty"`
+	ExecutedQty             string `json:"executedQty"`
+	CummulativeQuoteQty     string `json:"cummulativeQuoteQty"`
+	Status                  string `json:"status"`
+	TimeInForce             string `json:"timeInForce"`
+	Type                    string `json:"type"`
+	Side                    string `json:"side"`
+	StopPrice               string `json:"stopPrice"`
+	IcebergQty              string `json:"icebergQty"`
+	Time                    int64  `json:"time"`
+	UpdateTime              int64  `json:"updateTime"`
+	IsWorking               bool   `json:"isWorking"`
+	OrigQuoteOrderQty       string `json:"origQuoteOrderQty"`
+	WorkingTime             int64  `json:"workingTime"`
+	SelfTradePreventionMode string `json:"selfTradePreventionMode"`
+	PreventedMatchID        int64  `json:"preventedMatchId"`
+	PreventedQuantity       string `json:"preventedQuantity"`
+}
+
+// OrderRateLimit is the unfilled order count of an order rate limit interval
+type OrderRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// PreventedMatch is an order expired because of self trade prevention
+type PreventedMatch struct {
+	Symbol                  string          `json:"symbol"`
+	PreventedMatchID        int64           `json:"preventedMatchId"`
+	TakerOrderID            int64           `json:"takerOrderId"`
+	MakerOrderID            int64           `json:"makerOrderId"`
+	TradeGroupID            int64           `json:"tradeGroupId"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+	Price                   decimal.Decimal `json:"price"`
+	MakerPreventedQuantity  decimal.Decimal `json:"makerPreventedQuantity"`
+	TransactTime            int64           `json:"transactTime"`
 }
 
 // TradeHistoryList object for recent trade on binance
@@ -254,16 +291,22 @@ type WithdrawalsList []WithdrawalEntry
 
 // WithdrawalEntry object for withdraw from binance
 type WithdrawalEntry struct {
-	ID              string `json:"id"`
-	Address         string `json:"address"`
-	Amount          string `json:"amount"`
-	ApplyTime       string `json:"applyTime"`
-	Coin            string `json:"coin"`
-	WithdrawOrderId string `json:"withdrawOrderId"`
-	Network         string `json:"network"`
-	TransferType    int    `json:"transferType"`
-	Status          int    `json:"status"`
-	TxId            string `json:"txId"`
+	ID              string         `json:"id"`
+	Address         string         `json:"address"`
+	AddressTag      string         `json:"addressTag"`
+	Amount          string         `json:"amount"`
+	TransactionFee  string         `json:"transactionFee"`
+	ApplyTime       DateTime       `json:"applyTime"`
+	CompleteTime    DateTime       `json:"completeTime"`
+	Coin            string         `json:"coin"`
+	WithdrawOrderId string         `json:"withdrawOrderId"`
+	Network         string         `json:"network"`
+	TransferType    int            `json:"transferType"`
+	Status          WithdrawStatus `json:"status"`
+	TxId            string         `json:"txId"`
+	Info            string         `json:"info"`
+	ConfirmNo       int64          `json:"confirmNo"`
+	WalletType      int            `json:"walletType"`
 }
 
 // DepositsList ...
@@ -271,16 +314,19 @@ type DepositsList []DepositEntry
 
 // DepositEntry ...
 type DepositEntry struct {
-	Amount       string `json:"amount"`
-	Coin         string `json:"coin"`
-	Network      string `json:"network"`
-	Status       int    `json:"status"`
-	Address      string `json:"address"`
-	AddressTag   string `json:"addressTag"`
-	TxId         string `json:"txId"`
-	InsertTime   int64  `json:"insertTime"`
-	TransferType int    `json:"transferType"`
-	ConfirmTimes string `json:"confirmTimes"`
+	ID            string        `json:"id"`
+	Amount        string        `json:"amount"`
+	Coin          string        `json:"coin"`
+	Network       string        `json:"network"`
+	Status        DepositStatus `json:"status"`
+	Address       string        `json:"address"`
+	AddressTag    string        `json:"addressTag"`
+	TxId          string        `json:"txId"`
+	InsertTime    int64         `json:"insertTime"`
+	TransferType  int           `json:"transferType"`
+	ConfirmTimes  string        `json:"confirmTimes"`
+	UnlockConfirm int64         `json:"unlockConfirm"`
+	WalletType    int           `json:"walletType"`
 }
 
 // CancelResult ...
@@ -351,6 +397,9 @@ type FilterLimit struct {
 // BSymbol ...
 type BSymbol struct {
 	Symbol              string        `json:"symbol"`
+	Status              string        `json:"status"`
+	BaseAsset           string        `json:"baseAsset"`
+	QuoteAsset          string        `json:"quoteAsset"`
 	BaseAssetPrecision  int           `json:"baseAssetPrecision"`
 	QuoteAssetPrecision int           `json:"quoteAssetPrecision"`
 	Filters             []FilterLimit `json:"filters"`
@@ -385,11 +434,31 @@ type CreateOrderResult struct {
 	Side                string `json:"side"`
 }
 
+// TestOrderResult is returned by the test order endpoint, commission fields are only set when
+// computeCommissionRates is requested
+type TestOrderResult struct {
+	StandardCommissionForOrder struct {
+		Maker decimal.Decimal `json:"maker"`
+		Taker decimal.Decimal `json:"taker"`
+	} `json:"standardCommissionForOrder"`
+	TaxCommissionForOrder struct {
+		Maker decimal.Decimal `json:"maker"`
+		Taker decimal.Decimal `json:"taker"`
+	} `json:"taxCommissionForOrder"`
+	Discount struct {
+		EnabledForAccount bool            `json:"enabledForAccount"`
+		EnabledForSymbol  bool            `json:"enabledForSymbol"`
+		DiscountAsset     string          `json:"discountAsset"`
+		Discount          decimal.Decimal `json:"discount"`
+	} `json:"discount"`
+}
+
 // FutureOrder ...
 type FutureOrder struct {
 	ClientOrderID            string `json:"clientOrderId"`
 	CummulativeQuantity      string `json:"cumQty"`
 	CummulativeQuoteQuantity string `json:"cumQuote"`
+	CummulativeBaseQuantity  string `json:"cumBase"` // COIN-M only
 	ExecutedQuantity         string `json:"executedQty"`
 	OrderID                  uint64 `json:"orderId"`
 	AveragePrice             string `json:"avgPrice"`
@@ -402,6 +471,7 @@ type FutureOrder struct {
 	StopPrice                string `json:"stopPrice"`
 	ClosePosition            bool   `json:"closePosition"`
 	Symbol                   string `json:"symbol"`
+	Pair                     string `json:"pair"` // COIN-M only
 	TimeInForce              string `json:"timeInForce"`
 	Type                     string `json:"type"`
 	OriginType               string `json:"origType"`
@@ -410,6 +480,10 @@ type FutureOrder struct {
 	UpdateTime               uint64 `json:"updateTime"`
 	WorkingType              string `json:"workingType"`
 	PriceProtect             bool   `json:"priceProtect"`
+	PriceMatch               string `json:"priceMatch"`
+	SelfTradePreventionMode  string `json:"selfTradePreventionMode"`
+	GoodTillDate             int64  `json:"goodTillDate"`
+	Time                     uint64 `json:"time"`
 }
 
 // MarginAsset ..
@@ -431,6 +505,19 @@ type MarginPair struct {
 	IsMarginTrade bool   `json:"isMarginTrade"`
 	IsBuyAllowed  bool   `json:"isBuyAllowed"`
 	IsSellAllowed bool   `json:"isSellAllowed"`
+	DelistTime    int64  `json:"delistTime"`
+}
+
+// IsolatedMarginAccountResult ...
+type IsolatedMarginAccountResult struct {
+	Success bool   `json:"success"`
+	Symbol  string `json:"symbol"`
+}
+
+// IsolatedMarginAccountLimit ...
+type IsolatedMarginAccountLimit struct {
+	EnabledAccount int `json:"enabledAccount"`
+	MaxAccount     int `json:"maxAccount"`
 }
 
 // CrossMarginAccountDetails ...
@@ -458,33 +545,87 @@ type MaxBorrowableResult struct {
 	BorrowLimit string `json:"borrowLimit"`
 }
 
+// MaxTransferableResult ...
+type MaxTransferableResult struct {
+	Amount      decimal.Decimal `json:"amount"`
+	BorrowLimit decimal.Decimal `json:"borrowLimit"`
+}
+
+// CrossMarginData is the cross margin fee and limit of a coin for a vip level
+type CrossMarginData struct {
+	VipLevel        int             `json:"vipLevel"`
+	Coin            string          `json:"coin"`
+	TransferIn      bool            `json:"transferIn"`
+	Borrowable      bool            `json:"borrowable"`
+	DailyInterest   decimal.Decimal `json:"dailyInterest"`
+	YearlyInterest  decimal.Decimal `json:"yearlyInterest"`
+	BorrowLimit     decimal.Decimal `json:"borrowLimit"`
+	MarginablePairs []string        `json:"marginablePairs"`
+}
+
+// IsolatedMarginData is the isolated margin fee and limit of a symbol for a vip level
+type IsolatedMarginData struct {
+	VipLevel int    `json:"vipLevel"`
+	Symbol   string `json:"symbol"`
+	Leverage int64  `json:"leverage,string"`
+	Data     []struct {
+		Coin          string          `json:"coin"`
+		DailyInterest decimal.Decimal `json:"dailyInterest"`
+		BorrowLimit   decimal.Decimal `json:"borrowLimit"`
+	} `json:"data"`
+}
+
+// MarginPriceIndex ...
+type MarginPriceIndex struct {
+	CalcTime int64           `json:"calcTime"`
+	Price    decimal.Decimal `json:"price"`
+	Symbol   string          `json:"symbol"`
+}
+
 // CoinInfo ...
 type CoinInfo struct {
-	Coin             string `json:"coin"`
-	DepositAllEnable bool   `json:"depositAllEnable"`
-	Free             string `json:"free"`
-	Freeze           string `json:"freeze"`
-	IPOable          string `json:"ipoable"`
-	IsLegalMoney     bool   `json:"isLegalMoney"`
-	Locked           string `json:"locked"`
-	Name             string `json:"name"`
-	NetworkList      []struct {
-		AddressRegex       string `json:"addressRegex"`
-		Coin               string `json:"coin"`
-		DepositDesc        string `json:"depositDesc"`
-		DepositEnable      bool   `json:"depositEnable"`
-		IsDefault          bool   `json:"isDefault"`
-		MinConfirm         int64  `json:"minConfirm"`
-		Name               string `json:"name"`
-		Network            string `json:"network"`
-		ResetAddressStatus bool   `json:"resetAddressStatus"`
-		SpecialTips        string `json:"specialTips"`
-		UnLockConfirm      int64  `json:"unLockConfirm"`
-		WithdrawDesc       string `json:"withdrawDesc"`
-		WithdrawEnable     bool   `json:"withdrawEnable"`
-		WithdrawFee        string `json:"withdrawFee"`
-		WithdrawMin        string `json:"withdrawMin"`
-	} `json:"networkList"`
+	Coin             string        `json:"coin"`
+	DepositAllEnable bool          `json:"depositAllEnable"`
+	Free             string        `json:"free"`
+	Freeze           string        `json:"freeze"`
+	IPOable          string        `json:"ipoable"`
+	IsLegalMoney     bool          `json:"isLegalMoney"`
+	Locked           string        `json:"locked"`
+	Name             string        `json:"name"`
+	NetworkList      []CoinNetwork `json:"networkList"`
+}
+
+// CoinNetwork is a network a coin can be deposited or withdrawn on
+type CoinNetwork struct {
+	AddressRegex            string `json:"addressRegex"`
+	Coin                    string `json:"coin"`
+	DepositDesc             string `json:"depositDesc"`
+	DepositEnable           bool   `json:"depositEnable"`
+	IsDefault               bool   `json:"isDefault"`
+	MemoRegex               string `json:"memoRegex"`
+	MinConfirm              int64  `json:"minConfirm"`
+	Name                    string `json:"name"`
+	Network                 string `json:"network"`
+	ResetAddressStatus      bool   `json:"resetAddressStatus"`
+	SameAddress             bool   `json:"sameAddress"` // true if deposits need a memo, the address is shared
+	SpecialTips             string `json:"specialTips"`
+	UnLockConfirm           int64  `json:"unLockConfirm"`
+	WithdrawDesc            string `json:"withdrawDesc"`
+	WithdrawEnable          bool   `json:"withdrawEnable"`
+	WithdrawFee             string `json:"withdrawFee"`
+	WithdrawIntegerMultiple string `json:"withdrawIntegerMultiple"`
+	WithdrawMax             string `json:"withdrawMax"`
+	WithdrawMin             string `json:"withdrawMin"`
+}
+
+// FindNetwork return the network of the coin by name, an empty name returns the default network
+func (c CoinInfo) FindNetwork(network string) (CoinNetwork, bool) {
+	for _, n := range c.NetworkList {
+		if (network == "" && n.IsDefault) || (network != "" && n.Network == network) {
+			return n, true
+		}
+	}
+	return CoinNetwork{}, false
 }
 
 // AllCoinInfo ...
@@ -0,0 +1,204 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrConvertQuoteExpired is returned when accepting a quote past its valid time
+var ErrConvertQuoteExpired = errors.New("convert quote expired")
+
+// ConvertValidTime is how long a quote can be accepted
+type ConvertValidTime string
+
+const (
+	ConvertValid10s ConvertValidTime = "10s"
+	ConvertValid30s ConvertValidTime = "30s"
+	ConvertValid1m  ConvertValidTime = "1m"
+	ConvertValid2m  ConvertValidTime = "2m"
+)
+
+// ConvertPair is a pair which can be converted with its amount limits
+type ConvertPair struct {
+	FromAsset          string          `json:"fromAsset"`
+	ToAsset            string          `json:"toAsset"`
+	FromAssetMinAmount decimal.Decimal `json:"fromAssetMinAmount"`
+	FromAssetMaxAmount decimal.Decimal `json:"fromAssetMaxAmount"`
+	ToAssetMinAmount   decimal.Decimal `json:"toAssetMinAmount"`
+	ToAssetMaxAmount   decimal.Decimal `json:"toAssetMaxAmount"`
+}
+
+// ConvertQuoteRequest ask a quote, exactly one of FromAmount and ToAmount must be set.
+// Wallet is SpotWallet or FundingWallet, zero values are not sent.
+type ConvertQuoteRequest struct {
+	FromAsset  string
+	ToAsset    string
+	FromAmount decimal.Decimal
+	ToAmount   decimal.Decimal
+	Wallet     WalletType
+	ValidTime  ConvertValidTime
+}
+
+// ConvertQuote ...
+type ConvertQuote struct {
+	QuoteID        string          `json:"quoteId"`
+	Ratio          decimal.Decimal `json:"ratio"`
+	InverseRatio   decimal.Decimal `json:"inverseRatio"`
+	ValidTimestamp int64           `json:"validTimestamp"`
+	FromAmount     decimal.Decimal `json:"fromAmount"`
+	ToAmount       decimal.Decimal `json:"toAmount"`
+}
+
+// Expired return true if the quote can not be accepted anymore at now
+func (q ConvertQuote) Expired(now time.Time) bool {
+	return now.UnixNano()/int64(time.Millisecond) >= q.ValidTimestamp
+}
+
+// ConvertAcceptResult ...
+type ConvertAcceptResult struct {
+	OrderID     string `json:"orderId"`
+	CreateTime  int64  `json:"createTime"`
+	OrderStatus string `json:"orderStatus"` // PROCESS, ACCEPT_SUCCESS, SUCCESS or FAIL
+}
+
+// ConvertOrder ...
+type ConvertOrder struct {
+	QuoteID      string          `json:"quoteId"`
+	OrderID      int64           `json:"orderId"`
+	OrderStatus  string          `json:"orderStatus"`
+	FromAsset    string          `json:"fromAsset"`
+	FromAmount   decimal.Decimal `json:"fromAmount"`
+	ToAsset      string          `json:"toAsset"`
+	ToAmount     decimal.Decimal `json:"toAmount"`
+	Ratio        decimal.Decimal `json:"ratio"`
+	InverseRatio decimal.Decimal `json:"inverseRatio"`
+	CreateTime   int64           `json:"createTime"`
+}
+
+// ConvertTradeFlow ...
+type ConvertTradeFlow struct {
+	List      []ConvertOrder `json:"list"`
+	StartTime int64          `json:"startTime"`
+	EndTime   int64          `json:"endTime"`
+	Limit     int            `json:"limit"`
+	MoreData  bool           `json:"moreData"`
+}
+
+// GetConvertPairs return the convertible pairs, fromAsset and toAsset are optional filters
+func (bc *Client) GetConvertPairs(fromAsset, toAsset string) ([]ConvertPair, *FwdData, error) {
+	var (
+		result []ConvertPair
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/convert/exchangeInfo", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "fromAsset", fromAsset)
+	rr = withOptionalParam(rr, "toAsset", toAsset)
+	fwd, err := bc.doRequest(rr.Request(), &result)
+	return result, fwd, err
+}
+
+// GetConvertQuote ask a quote, it must be accepted with AcceptConvertQuote before it expires
+func (bc *Client) GetConvertQuote(r ConvertQuoteRequest) (ConvertQuote, *FwdData, error) {
+	var (
+		result ConvertQuote
+	)
+	if r.FromAmount.IsZero() == r.ToAmount.IsZero() {
+		return result, nil, fmt.Errorf("exactly one of from amount and to amount must be set")
+	}
+	requestURL := fmt.Sprintf("%s/sapi/v1/convert/getQuote", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("fromAsset", r.FromAsset).
+		WithParam("toAsset", r.ToAsset)
+	rr = withDecimalParam(rr, "fromAmount", r.FromAmount)
+	rr = withDecimalParam(rr, "toAmount", r.ToAmount)
+	rr = withOptionalParam(rr, "validTime", string(r.ValidTime))
+	switch r.Wallet {
+	case 0:
+	case SpotWallet, FundingWallet:
+		rr = rr.WithParam("walletType", r.Wallet.String())
+	default:
+		return result, nil, fmt.Errorf("can not convert from %s wallet", r.Wallet)
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// AcceptConvertQuote accept a quote, it fails without calling binance if the quote already expired
+func (bc *Client) AcceptConvertQuote(quote ConvertQuote) (ConvertAcceptResult, *FwdData, error) {
+	var (
+		result ConvertAcceptResult
+	)
+	if quote.Expired(time.Now()) {
+		return result, nil, fmt.Errorf("%w: %s", ErrConvertQuoteExpired, quote.QuoteID)
+	}
+	requestURL := fmt.Sprintf("%s/sapi/v1/convert/acceptQuote", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("quoteId", quote.QuoteID).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// Convert ask a quote and accept it right away
+func (bc *Client) Convert(r ConvertQuoteRequest) (ConvertQuote, ConvertAcceptResult, error) {
+	quote, _, err := bc.GetConvertQuote(r)
+	if err != nil {
+		return quote, ConvertAcceptResult{}, fmt.Errorf("failed to get convert quote, %w", err)
+	}
+	result, _, err := bc.AcceptConvertQuote(quote)
+	if err != nil {
+		return quote, result, fmt.Errorf("failed to accept convert quote %s, %w", quote.QuoteID, err)
+	}
+	return quote, result, nil
+}
+
+// GetConvertOrder return a convert order by order id or quote id
+func (bc *Client) GetConvertOrder(orderID, quoteID string) (ConvertOrder, *FwdData, error) {
+	var (
+		result ConvertOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/convert/orderStatus", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "orderId", orderID)
+	rr = withOptionalParam(rr, "quoteId", quoteID)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetConvertTradeFlow return convert orders between startTime and endTime, the range is at most 30 days and limit at
+// most 1000
+func (bc *Client) GetConvertTradeFlow(startTime, endTime int64, limit int) (ConvertTradeFlow, *FwdData, error) {
+	var (
+		result ConvertTradeFlow
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/convert/tradeFlow", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("startTime", strconv.FormatInt(startTime, 10)).
+		WithParam("endTime", strconv.FormatInt(endTime, 10))
+	rr = withTimeRangeParams(rr, 0, 0, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
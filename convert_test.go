@@ -0,0 +1,133 @@
+package binance
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestGetConvertQuoteParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        ConvertQuoteRequest
+		wantErr    bool
+		wantParams map[string]string
+	}{
+		{
+			name:       "from amount",
+			req:        ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC", FromAmount: decimal.NewFromInt(100)},
+			wantParams: map[string]string{"fromAsset": "USDT", "toAsset": "BTC", "fromAmount": "100"},
+		},
+		{
+			name:       "to amount from spot wallet",
+			req:        ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC", ToAmount: decimal.RequireFromString("0.01"), Wallet: SpotWallet},
+			wantParams: map[string]string{"fromAsset": "USDT", "toAsset": "BTC", "toAmount": "0.01", "walletType": "SPOT"},
+		},
+		{
+			name: "funding wallet and valid time",
+			req: ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC", FromAmount: decimal.NewFromInt(100),
+				Wallet: FundingWallet, ValidTime: ConvertValid30s},
+			wantParams: map[string]string{"fromAsset": "USDT", "toAsset": "BTC", "fromAmount": "100",
+				"walletType": "FUNDING", "validTime": "30s"},
+		},
+		{
+			name:    "no amount",
+			req:     ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC"},
+			wantErr: true,
+		},
+		{
+			name: "both amounts",
+			req: ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC", FromAmount: decimal.NewFromInt(100),
+				ToAmount: decimal.NewFromInt(1)},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported wallet",
+			req:     ConvertQuoteRequest{FromAsset: "USDT", ToAsset: "BTC", FromAmount: decimal.NewFromInt(100), Wallet: CrossMarginWallet},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/sapi/v1/convert/getQuote" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				params := signedParams(r)
+				if len(params) != len(tt.wantParams) {
+					t.Errorf("params = %v, want %v", params, tt.wantParams)
+				}
+				for key, want := range tt.wantParams {
+					if params[key] != want {
+						t.Errorf("%s = %q, want %q", key, params[key], want)
+					}
+				}
+				writeJSON(t, w, map[string]interface{}{"quoteId": "q1", "ratio": "0.00002"})
+			})
+			quote, _, err := bc.GetConvertQuote(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetConvertQuote() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if calls != 0 {
+					t.Errorf("%d requests sent for an invalid quote request", calls)
+				}
+				return
+			}
+			if quote.QuoteID != "q1" {
+				t.Errorf("QuoteID = %s, want q1", quote.QuoteID)
+			}
+		})
+	}
+}
+
+func TestConvertQuoteExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	quote := ConvertQuote{QuoteID: "q1", ValidTimestamp: now.UnixNano() / int64(time.Millisecond)}
+	if quote.Expired(now.Add(-time.Millisecond)) {
+		t.Error("quote expired before its valid timestamp")
+	}
+	if !quote.Expired(now) {
+		t.Error("quote not expired at its valid timestamp")
+	}
+}
+
+func TestAcceptConvertQuote(t *testing.T) {
+	nowMillis := time.Now().UnixNano() / int64(time.Millisecond)
+	tests := []struct {
+		name      string
+		quote     ConvertQuote
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "valid quote", quote: ConvertQuote{QuoteID: "q1", ValidTimestamp: nowMillis + 60000}, wantCalls: 1},
+		{name: "expired quote", quote: ConvertQuote{QuoteID: "q1", ValidTimestamp: nowMillis - 1}, wantErr: ErrConvertQuoteExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/sapi/v1/convert/acceptQuote" || r.URL.Query().Get("quoteId") != "q1" {
+					t.Errorf("unexpected request %s", r.URL)
+				}
+				writeJSON(t, w, map[string]interface{}{"orderId": "o1", "orderStatus": "PROCESS"})
+			})
+			result, _, err := bc.AcceptConvertQuote(tt.quote)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AcceptConvertQuote() error = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if tt.wantErr == nil && result.OrderID != "o1" {
+				t.Errorf("OrderID = %s, want o1", result.OrderID)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// DustAccountType is the account small balances are converted from
+type DustAccountType string
+
+const (
+	DustSpotAccount   DustAccountType = "SPOT"
+	DustMarginAccount DustAccountType = "MARGIN"
+)
+
+// DustAssets is the assets which can be converted to BNB
+type DustAssets struct {
+	Details []struct {
+		Asset            string          `json:"asset"`
+		AssetFullName    string          `json:"assetFullName"`
+		AmountFree       decimal.Decimal `json:"amountFree"`
+		ToBTC            decimal.Decimal `json:"toBTC"`
+		ToBNB            decimal.Decimal `json:"toBNB"`
+		ToBNBOffExchange decimal.Decimal `json:"toBNBOffExchange"`
+		Exchange         decimal.Decimal `json:"exchange"`
+	} `json:"details"`
+	TotalTransferBtc   decimal.Decimal `json:"totalTransferBtc"`
+	TotalTransferBNB   decimal.Decimal `json:"totalTransferBNB"`
+	DribbletPercentage decimal.Decimal `json:"dribbletPercentage"`
+}
+
+// DustTransferDetail ...
+type DustTransferDetail struct {
+	TranID              uint64          `json:"tranId"`
+	FromAsset           string          `json:"fromAsset"`
+	Amount              decimal.Decimal `json:"amount"`
+	TransferedAmount    decimal.Decimal `json:"transferedAmount"`
+	ServiceChargeAmount decimal.Decimal `json:"serviceChargeAmount"`
+	OperateTime         int64           `json:"operateTime"`
+}
+
+// DustTransferResult ...
+type DustTransferResult struct {
+	TotalServiceCharge decimal.Decimal      `json:"totalServiceCharge"`
+	TotalTransfered    decimal.Decimal      `json:"totalTransfered"`
+	TransferResult     []DustTransferDetail `json:"transferResult"`
+}
+
+// DustLog is a conversion of small balances to BNB
+type DustLog struct {
+	TransID                  uint64               `json:"transId"`
+	OperateTime              int64                `json:"operateTime"`
+	TotalTransferedAmount    decimal.Decimal      `json:"totalTransferedAmount"`
+	TotalServiceChargeAmount decimal.Decimal      `json:"totalServiceChargeAmount"`
+	UserAssetDribbletDetails []DustTransferDetail `json:"userAssetDribbletDetails"`
+}
+
+// GetDustAssets return the assets of the account which can be converted to BNB, an empty account type means spot
+func (bc *Client) GetDustAssets(accountType DustAccountType) (DustAssets, *FwdData, error) {
+	var (
+		result DustAssets
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/dust-btc", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "accountType", string(accountType))
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// ConvertDust convert small balances of assets to BNB, an empty account type means spot
+func (bc *Client) ConvertDust(assets []string, accountType DustAccountType) (DustTransferResult, *FwdData, error) {
+	var (
+		result DustTransferResult
+	)
+	if len(assets) == 0 {
+		return result, nil, fmt.Errorf("no asset to convert")
+	}
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/dust", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParamValues("asset", assets...)
+	rr = withOptionalParam(rr, "accountType", string(accountType))
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// GetDustLog return the conversions of small balances to BNB and the total number of them
+func (bc *Client) GetDustLog(startTime, endTime int64) ([]DustLog, int64, *FwdData, error) {
+	var (
+		result struct {
+			Total              int64     `json:"total"`
+			UserAssetDribblets []DustLog `json:"userAssetDribblets"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/dribblet", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	rr := withTimeRangeParams(req.WithHeader(apiKeyHeader, bc.apiKey), startTime, endTime, 0)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.UserAssetDribblets, result.Total, fwd, err
+}
+
+// SelectDustAssets return the assets of account which free balance can not be sold for quoteAsset because it is below
+// the min quantity or the min notional of the symbol, at the bid price of tickers. Assets without a trading symbol
+// against quoteAsset and the ones in exclude are skipped.
+func SelectDustAssets(account AccountState, info ExchangeInfo, tickers []TickerEntry, quoteAsset string, exclude ...string) ([]string, error) {
+	symbols := make(map[string]BSymbol, len(info.Symbols))
+	for _, s := range info.Symbols {
+		if s.QuoteAsset == quoteAsset && (s.Status == "" || s.Status == "TRADING") {
+			symbols[s.BaseAsset] = s
+		}
+	}
+	bids := make(map[string]string, len(tickers))
+	for _, t := range tickers {
+		bids[t.Symbol] = t.BidPrice
+	}
+	var dust []string
+	for _, b := range account.Balances {
+		if b.Asset == quoteAsset || containsString(exclude, b.Asset) {
+			continue
+		}
+		free, err := decimal.NewFromString(b.Free)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s balance %q, %w", b.Asset, b.Free, err)
+		}
+		if !free.IsPositive() {
+			continue
+		}
+		symbol, ok := symbols[b.Asset]
+		if !ok {
+			continue
+		}
+		minQty, minNotional, err := symbolMinimums(symbol)
+		if err != nil {
+			return nil, err
+		}
+		if free.LessThan(minQty) {
+			dust = append(dust, b.Asset)
+			continue
+		}
+		bid, ok := bids[symbol.Symbol]
+		if !ok || minNotional.IsZero() {
+			continue
+		}
+		price, err := decimal.NewFromString(bid)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s bid price %q, %w", symbol.Symbol, bid, err)
+		}
+		if free.Mul(price).LessThan(minNotional) {
+			dust = append(dust, b.Asset)
+		}
+	}
+	sort.Strings(dust)
+	return dust, nil
+}
+
+func symbolMinimums(symbol BSymbol) (minQty, minNotional decimal.Decimal, err error) {
+	for _, f := range symbol.Filters {
+		switch {
+		case f.FilterType == "LOT_SIZE" && f.MinQuantity != "":
+			if minQty, err = decimal.NewFromString(f.MinQuantity); err != nil {
+				return minQty, minNotional, fmt.Errorf("invalid %s minQty %q, %w", symbol.Symbol, f.MinQuantity, err)
+			}
+		case (f.FilterType == "MIN_NOTIONAL" || f.FilterType == "NOTIONAL") && f.MinNotional != "":
+			if minNotional, err = decimal.NewFromString(f.MinNotional); err != nil {
+				return minQty, minNotional, fmt.Errorf("invalid %s minNotional %q, %w", symbol.Symbol, f.MinNotional, err)
+			}
+		}
+	}
+	return minQty, minNotional, nil
+}
@@ -0,0 +1,86 @@
+package binance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelectDustAssets(t *testing.T) {
+	symbol := func(base, status, minQty, minNotional string) BSymbol {
+		return BSymbol{
+			Symbol:     base + "BTC",
+			Status:     status,
+			BaseAsset:  base,
+			QuoteAsset: "BTC",
+			Filters: []FilterLimit{
+				{FilterType: "LOT_SIZE", MinQuantity: minQty},
+				{FilterType: "NOTIONAL", MinNotional: minNotional},
+			},
+		}
+	}
+	info := ExchangeInfo{Symbols: []BSymbol{
+		symbol("ETH", "TRADING", "0.001", "0.0001"),
+		symbol("XRP", "TRADING", "1", "0.0001"),
+		symbol("DOGE", "TRADING", "1", "0.0001"),
+		symbol("LUNA", "BREAK", "1", "0.0001"),
+		{Symbol: "ADAUSDT", Status: "TRADING", BaseAsset: "ADA", QuoteAsset: "USDT"},
+	}}
+	tickers := []TickerEntry{
+		{Symbol: "ETHBTC", BidPrice: "0.05"},
+		{Symbol: "XRPBTC", BidPrice: "0.00001"},
+	}
+	tests := []struct {
+		name     string
+		balances []Balance
+		exclude  []string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "below min quantity",
+			balances: []Balance{
+				{Asset: "ETH", Free: "0.0005"},
+				{Asset: "XRP", Free: "0.5"},
+			},
+			want: []string{"ETH", "XRP"},
+		},
+		{
+			name: "below min notional at bid price",
+			balances: []Balance{
+				{Asset: "ETH", Free: "0.001"}, // 0.00005 BTC
+				{Asset: "XRP", Free: "20"},    // 0.0002 BTC
+			},
+			want: []string{"ETH"},
+		},
+		{
+			name: "skipped assets",
+			balances: []Balance{
+				{Asset: "BTC", Free: "0.00001"},
+				{Asset: "DOGE", Free: "2"}, // no ticker to value it
+				{Asset: "LUNA", Free: "0.1"},
+				{Asset: "ADA", Free: "0.1"},
+				{Asset: "BNB", Free: "0.1"},
+				{Asset: "XRP", Free: "0"},
+				{Asset: "ETH", Free: "0.0001"},
+			},
+			exclude: []string{"ETH"},
+			want:    nil,
+		},
+		{
+			name:     "invalid balance",
+			balances: []Balance{{Asset: "ETH", Free: "abc"}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectDustAssets(AccountState{Balances: tt.balances}, info, tickers, "BTC", tt.exclude...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SelectDustAssets() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SelectDustAssets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
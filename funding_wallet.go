@@ -0,0 +1,138 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// UserAsset is the balance of an asset in the spot wallet
+type UserAsset struct {
+	Asset        string          `json:"asset"`
+	Free         decimal.Decimal `json:"free"`
+	Locked       decimal.Decimal `json:"locked"`
+	Freeze       decimal.Decimal `json:"freeze"`
+	Withdrawing  decimal.Decimal `json:"withdrawing"`
+	Ipoable      decimal.Decimal `json:"ipoable"`
+	BtcValuation decimal.Decimal `json:"btcValuation"`
+}
+
+// WalletBalance is the balance of an asset over the spot and funding wallets
+type WalletBalance struct {
+	Asset         string
+	SpotFree      decimal.Decimal
+	SpotLocked    decimal.Decimal
+	FundingFree   decimal.Decimal
+	FundingLocked decimal.Decimal // locked, frozen and withdrawing
+}
+
+// Free return the free balance of both wallets
+func (b WalletBalance) Free() decimal.Decimal {
+	return b.SpotFree.Add(b.FundingFree)
+}
+
+// Total return the whole balance of both wallets
+func (b WalletBalance) Total() decimal.Decimal {
+	return b.Free().Add(b.SpotLocked).Add(b.FundingLocked)
+}
+
+// GetFundingWalletAssets return funding wallet balances of asset (all assets if empty), btc valuation is only filled
+// if needBtcValuation is true
+func (bc *Client) GetFundingWalletAssets(asset string, needBtcValuation bool) ([]FundingWalletBalance, *FwdData, error) {
+	var result []FundingWalletBalance
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/get-funding-asset", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "asset", asset)
+	if needBtcValuation {
+		rr = rr.WithParam("needBtcValuation", strconv.FormatBool(needBtcValuation))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetUserAssets return the non zero balances of asset (all assets if empty) in the spot wallet
+func (bc *Client) GetUserAssets(asset string, needBtcValuation bool) ([]UserAsset, *FwdData, error) {
+	var result []UserAsset
+	requestURL := fmt.Sprintf("%s/sapi/v3/asset/getUserAsset", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "asset", asset)
+	if needBtcValuation {
+		rr = rr.WithParam("needBtcValuation", strconv.FormatBool(needBtcValuation))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// TransferFundingToSpot move funds from the funding wallet to the spot wallet
+func (bc *Client) TransferFundingToSpot(asset string, amount decimal.Decimal) (uint64, *FwdData, error) {
+	return bc.UniversalTransfer(UniversalTransferRequest{
+		Type:   TransferFundingMain,
+		Asset:  asset,
+		Amount: amount,
+	})
+}
+
+// TransferSpotToFunding move funds from the spot wallet to the funding wallet
+func (bc *Client) TransferSpotToFunding(asset string, amount decimal.Decimal) (uint64, *FwdData, error) {
+	return bc.UniversalTransfer(UniversalTransferRequest{
+		Type:   TransferMainFunding,
+		Asset:  asset,
+		Amount: amount,
+	})
+}
+
+// AggregateWalletBalances merge spot balances, e.g. from GetAccountState, with funding wallet balances, zero balances
+// are skipped and the result is sorted by asset
+func AggregateWalletBalances(spot []Balance, funding []FundingWalletBalance) ([]WalletBalance, error) {
+	balances := make(map[string]*WalletBalance)
+	get := func(asset string) *WalletBalance {
+		b, ok := balances[asset]
+		if !ok {
+			b = &WalletBalance{Asset: asset}
+			balances[asset] = b
+		}
+		return b
+	}
+	for _, s := range spot {
+		free, err := decimal.NewFromString(s.Free)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s free balance %q, %w", s.Asset, s.Free, err)
+		}
+		locked, err := decimal.NewFromString(s.Locked)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s locked balance %q, %w", s.Asset, s.Locked, err)
+		}
+		if free.IsZero() && locked.IsZero() {
+			continue
+		}
+		b := get(s.Asset)
+		b.SpotFree = b.SpotFree.Add(free)
+		b.SpotLocked = b.SpotLocked.Add(locked)
+	}
+	for _, f := range funding {
+		locked := f.Locked.Add(f.Freeze).Add(f.Withdrawing)
+		if f.Free.IsZero() && locked.IsZero() {
+			continue
+		}
+		b := get(f.Asset)
+		b.FundingFree = b.FundingFree.Add(f.Free)
+		b.FundingLocked = b.FundingLocked.Add(locked)
+	}
+	result := make([]WalletBalance, 0, len(balances))
+	for _, b := range balances {
+		result = append(result, *b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Asset < result[j].Asset
+	})
+	return result, nil
+}
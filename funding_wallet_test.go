@@ -0,0 +1,75 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAggregateWalletBalances(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name    string
+		spot    []Balance
+		funding []FundingWalletBalance
+		want    []WalletBalance
+		wantErr bool
+	}{
+		{
+			name: "merge by asset",
+			spot: []Balance{
+				{Asset: "USDT", Free: "10", Locked: "2"},
+				{Asset: "BTC", Free: "0.5", Locked: "0"},
+			},
+			funding: []FundingWalletBalance{
+				{Asset: "USDT", Free: d("5"), Locked: d("1"), Freeze: d("1"), Withdrawing: d("1")},
+				{Asset: "BNB", Free: d("3")},
+			},
+			want: []WalletBalance{
+				{Asset: "BNB", FundingFree: d("3")},
+				{Asset: "BTC", SpotFree: d("0.5")},
+				{Asset: "USDT", SpotFree: d("10"), SpotLocked: d("2"), FundingFree: d("5"), FundingLocked: d("3")},
+			},
+		},
+		{
+			name:    "skip zero balances",
+			spot:    []Balance{{Asset: "ETH", Free: "0.00000000", Locked: "0.00000000"}},
+			funding: []FundingWalletBalance{{Asset: "BNB"}},
+			want:    []WalletBalance{},
+		},
+		{
+			name:    "invalid free balance",
+			spot:    []Balance{{Asset: "USDT", Free: "abc", Locked: "0"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid locked balance",
+			spot:    []Balance{{Asset: "USDT", Free: "1", Locked: ""}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AggregateWalletBalances(tt.spot, tt.funding)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AggregateWalletBalances() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("AggregateWalletBalances() = %+v, want %+v", got, tt.want)
+			}
+			for i, want := range tt.want {
+				b := got[i]
+				if b.Asset != want.Asset || !b.SpotFree.Equal(want.SpotFree) || !b.SpotLocked.Equal(want.SpotLocked) ||
+					!b.FundingFree.Equal(want.FundingFree) || !b.FundingLocked.Equal(want.FundingLocked) {
+					t.Errorf("balance %d = %+v, want %+v", i, b, want)
+				}
+			}
+			if len(got) > 0 && !got[len(got)-1].Total().Equal(d("20")) {
+				t.Errorf("USDT total = %s, want 20", got[len(got)-1].Total())
+			}
+		})
+	}
+}
@@ -2,9 +2,10 @@ package binance
 
 import (
 	"fmt"
-	"github.com/shopspring/decimal"
 	"net/http"
-	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -12,75 +13,75 @@ var (
 	COINMAPI = "https://dapi.binance.com"
 )
 
-// CreateFutureOrder ...
+// futureAPI is the base url and path prefix of a futures product, USD-M is served under /fapi and COIN-M under /dapi
+type futureAPI struct {
+	baseURL string
+	prefix  string
+}
+
+func (bc *Client) usdmFutureAPI() futureAPI {
+	return futureAPI{baseURL: bc.futureAPIBaseURL, prefix: "fapi"}
+}
+
+func (bc *Client) coinMFutureAPI() futureAPI {
+	return futureAPI{baseURL: bc.coinMFutureAPIBaseURL, prefix: "dapi"}
+}
+
+func (f futureAPI) url(apiPath string) string {
+	return fmt.Sprintf("%s/%s/%s", f.baseURL, f.prefix, apiPath)
+}
+
+// hasTestOrder return true if the product has a test endpoint for order placement
+func (f futureAPI) hasTestOrder() bool {
+	return f.prefix == "fapi"
+}
+
+// CreateFutureOrder place a futures order, zero prices are not sent.
+//
+// Deprecated: use PlaceFutureOrder.
 func (bc *Client) CreateFutureOrder(symbol, side, positionSide, tradeType, timeInForce, reduceOnly, newClientOrderID, closePosition, workingType, priceProtect, newOrderRespType string,
 	price, stopPrice, activationPrice, callbackRate, quantity float64) (FutureOrder, error) {
-	var (
-		response FutureOrder
-	)
-	requestURL := fmt.Sprintf("%s/fapi/v1/order", bc.futureAPIBaseURL)
-	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
-	if err != nil {
-		return response, err
-	}
-	quantityStr := strconv.FormatFloat(quantity, 'f', -1, 64)
-	priceStr := strconv.FormatFloat(price, 'f', -1, 64)
-	rrb := req.WithHeader(apiKeyHeader, bc.apiKey).
-		WithParam("symbol", symbol).
-		WithParam("side", side).
-		WithParam("type", tradeType).
-		WithParam("positionSide", positionSide).
-		WithParam("quantity", quantityStr).
-		WithParam("price", priceStr)
-	if timeInForce != "" {
-		rrb = rrb.WithParam("timeInForce", timeInForce)
-	}
-	if newClientOrderID != "" {
-		rrb = rrb.WithParam("newClientOrderId", newClientOrderID)
-	}
-	if closePosition != "" {
-		rrb = rrb.WithParam("closePosition", closePosition)
-	}
-	if workingType != "" {
-		rrb = rrb.WithParam("workingType", workingType)
-	}
-	if priceProtect != "" {
-		rrb = rrb.WithParam("priceProtect", priceProtect)
-	}
-	if newOrderRespType != "" {
-		rrb = rrb.WithParam("newOrderRespType", newOrderRespType)
-	}
-	if stopPrice != 0 {
-		stopPriceStr := strconv.FormatFloat(stopPrice, 'f', -1, 64)
-		rrb = rrb.WithParam("stopPrice", stopPriceStr)
-	}
-	if activationPrice != 0 {
-		activationPriceStr := strconv.FormatFloat(activationPrice, 'f', -1, 64)
-		rrb = rrb.WithParam("activationPrice", activationPriceStr)
-	}
-	if callbackRate != 0 {
-		callbackRateStr := strconv.FormatFloat(callbackRate, 'f', -1, 64)
-		rrb = rrb.WithParam("callbackRate", callbackRateStr)
-	}
-	rr := rrb.SignedRequest(bc.secretKey)
-	_, err = bc.doRequest(rr, &response)
+	response, _, err := bc.PlaceFutureOrder(FutureOrderRequest{
+		Symbol:           symbol,
+		Side:             side,
+		PositionSide:     positionSide,
+		Type:             tradeType,
+		TimeInForce:      timeInForce,
+		Quantity:         decimal.NewFromFloat(quantity),
+		Price:            decimal.NewFromFloat(price),
+		StopPrice:        decimal.NewFromFloat(stopPrice),
+		ActivationPrice:  decimal.NewFromFloat(activationPrice),
+		CallbackRate:     decimal.NewFromFloat(callbackRate),
+		ReduceOnly:       strings.EqualFold(reduceOnly, "true"),
+		ClosePosition:    strings.EqualFold(closePosition, "true"),
+		PriceProtect:     strings.EqualFold(priceProtect, "true"),
+		NewClientOrderID: newClientOrderID,
+		WorkingType:      workingType,
+		NewOrderRespType: newOrderRespType,
+	})
 	return response, err
 }
 
 // PositionInformation ...
 type PositionInformation struct {
-	EntryPrice       string `json:"entryPrice"`
-	MarginType       string `json:"marginType"`
-	IsAutoAddMargin  string `json:"isAutoAddMargin"`
-	IsolatedMargin   string `json:"isolatedMargin"`
-	Leverage         string `json:"leverage"`
-	LiquidationPrice string `json:"liquidationPrice"`
-	MarkPrice        string `json:"markPrice"`
-	MaxNotionalValue string `json:"maxNotionalValue"`
-	PositionAmt      string `json:"positionAmt"`
-	Symbol           string `json:"symbol"`
-	UnrealizedProfit string `json:"unRealizedProfit"`
-	PositionSide     string `json:"positionSide"`
+	EntryPrice       decimal.Decimal `json:"entryPrice"`
+	BreakEvenPrice   decimal.Decimal `json:"breakEvenPrice"`
+	MarginType       string          `json:"marginType"`
+	IsAutoAddMargin  bool            `json:"isAutoAddMargin,string"`
+	IsolatedMargin   decimal.Decimal `json:"isolatedMargin"`
+	IsolatedWallet   decimal.Decimal `json:"isolatedWallet"`
+	Leverage         int64           `json:"leverage,string"`
+	LiquidationPrice decimal.Decimal `json:"liquidationPrice"`
+	MarkPrice        decimal.Decimal `json:"markPrice"`
+	MaxNotionalValue decimal.Decimal `json:"maxNotionalValue"`
+	Notional         decimal.Decimal `json:"notional"`
+	PositionAmt      decimal.Decimal `json:"positionAmt"`
+	Symbol           string          `json:"symbol"`
+	UnrealizedProfit decimal.Decimal `json:"unRealizedProfit"`
+	PositionSide     string          `json:"positionSide"`
+	UpdateTime       int64           `json:"updateTime"`
+	MaxQty           decimal.Decimal `json:"maxQty"`        // COIN-M only
+	NotionalValue    decimal.Decimal `json:"notionalValue"` // COIN-M only
 }
 
 // GetPositionInformation ...
@@ -92,7 +93,7 @@ func (bc *Client) GetPositionInformation(symbol string) ([]PositionInformation,
 	requestURL := fmt.Sprintf("%s/fapi/v2/positionRisk", bc.futureAPIBaseURL)
 	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
 	if err != nil {
-		return response, nil
+		return response, err
 	}
 	if symbol == "" {
 		rr = req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
@@ -150,7 +151,7 @@ func (bc *Client) CoinMFutureAccountBalance() ([]CoinMFutureAccountBalance, *Fwd
 	var (
 		response []CoinMFutureAccountBalance
 	)
-	requestURL := fmt.Sprintf("%s/dapi/v1/balance", COINMAPI)
+	requestURL := bc.coinMFutureAPI().url("v1/balance")
 	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
 	if err != nil {
 		return response, nil, err
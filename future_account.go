@@ -0,0 +1,280 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+const maxFutureIncomeLimit = 1000
+
+// FutureAccount is the USD-M futures account information
+type FutureAccount struct {
+	FeeTier                     int                     `json:"feeTier"`
+	CanTrade                    bool                    `json:"canTrade"`
+	CanDeposit                  bool                    `json:"canDeposit"`
+	CanWithdraw                 bool                    `json:"canWithdraw"`
+	UpdateTime                  int64                   `json:"updateTime"`
+	MultiAssetsMargin           bool                    `json:"multiAssetsMargin"`
+	TotalInitialMargin          decimal.Decimal         `json:"totalInitialMargin"`
+	TotalMaintMargin            decimal.Decimal         `json:"totalMaintMargin"`
+	TotalWalletBalance          decimal.Decimal         `json:"totalWalletBalance"`
+	TotalUnrealizedProfit       decimal.Decimal         `json:"totalUnrealizedProfit"`
+	TotalMarginBalance          decimal.Decimal         `json:"totalMarginBalance"`
+	TotalPositionInitialMargin  decimal.Decimal         `json:"totalPositionInitialMargin"`
+	TotalOpenOrderInitialMargin decimal.Decimal         `json:"totalOpenOrderInitialMargin"`
+	TotalCrossWalletBalance     decimal.Decimal         `json:"totalCrossWalletBalance"`
+	TotalCrossUnPnl             decimal.Decimal         `json:"totalCrossUnPnl"`
+	AvailableBalance            decimal.Decimal         `json:"availableBalance"`
+	MaxWithdrawAmount           decimal.Decimal         `json:"maxWithdrawAmount"`
+	Assets                      []FutureAccountAsset    `json:"assets"`
+	Positions                   []FutureAccountPosition `json:"positions"`
+}
+
+// FutureAccountAsset ...
+type FutureAccountAsset struct {
+	Asset                  string          `json:"asset"`
+	WalletBalance          decimal.Decimal `json:"walletBalance"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	MarginBalance          decimal.Decimal `json:"marginBalance"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	CrossWalletBalance     decimal.Decimal `json:"crossWalletBalance"`
+	CrossUnPnl             decimal.Decimal `json:"crossUnPnl"`
+	AvailableBalance       decimal.Decimal `json:"availableBalance"`
+	MaxWithdrawAmount      decimal.Decimal `json:"maxWithdrawAmount"`
+	MarginAvailable        bool            `json:"marginAvailable"`
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+// FutureAccountPosition ...
+type FutureAccountPosition struct {
+	Symbol                 string          `json:"symbol"`
+	InitialMargin          decimal.Decimal `json:"initialMargin"`
+	MaintMargin            decimal.Decimal `json:"maintMargin"`
+	UnrealizedProfit       decimal.Decimal `json:"unrealizedProfit"`
+	PositionInitialMargin  decimal.Decimal `json:"positionInitialMargin"`
+	OpenOrderInitialMargin decimal.Decimal `json:"openOrderInitialMargin"`
+	Leverage               int64           `json:"leverage,string"`
+	Isolated               bool            `json:"isolated"`
+	EntryPrice             decimal.Decimal `json:"entryPrice"`
+	MaxNotional            decimal.Decimal `json:"maxNotional"`
+	BidNotional            decimal.Decimal `json:"bidNotional"`
+	AskNotional            decimal.Decimal `json:"askNotional"`
+	PositionSide           string          `json:"positionSide"`
+	PositionAmt            decimal.Decimal `json:"positionAmt"`
+	MaxQty                 decimal.Decimal `json:"maxQty"` // COIN-M only
+	UpdateTime             int64           `json:"updateTime"`
+}
+
+// FutureIncomeType is the type of a futures income record
+type FutureIncomeType string
+
+const (
+	FutureIncomeTransfer                FutureIncomeType = "TRANSFER"
+	FutureIncomeWelcomeBonus            FutureIncomeType = "WELCOME_BONUS"
+	FutureIncomeRealizedPNL             FutureIncomeType = "REALIZED_PNL"
+	FutureIncomeFundingFee              FutureIncomeType = "FUNDING_FEE"
+	FutureIncomeCommission              FutureIncomeType = "COMMISSION"
+	FutureIncomeInsuranceClear          FutureIncomeType = "INSURANCE_CLEAR"
+	FutureIncomeReferralKickback        FutureIncomeType = "REFERRAL_KICKBACK"
+	FutureIncomeCommissionRebate        FutureIncomeType = "COMMISSION_REBATE"
+	FutureIncomeAPIRebate               FutureIncomeType = "API_REBATE"
+	FutureIncomeContestReward           FutureIncomeType = "CONTEST_REWARD"
+	FutureIncomeCrossCollateralTransfer FutureIncomeType = "CROSS_COLLATERAL_TRANSFER"
+	FutureIncomeInternalTransfer        FutureIncomeType = "INTERNAL_TRANSFER"
+	FutureIncomeAutoExchange            FutureIncomeType = "AUTO_EXCHANGE"
+	FutureIncomeDeliveredSettlement     FutureIncomeType = "DELIVERED_SETTELMENT"
+	FutureIncomeCoinSwapDeposit         FutureIncomeType = "COIN_SWAP_DEPOSIT"
+	FutureIncomeCoinSwapWithdraw        FutureIncomeType = "COIN_SWAP_WITHDRAW"
+)
+
+// FutureIncome is a futures income record
+type FutureIncome struct {
+	Symbol     string           `json:"symbol"`
+	IncomeType FutureIncomeType `json:"incomeType"`
+	Income     decimal.Decimal  `json:"income"`
+	Asset      string           `json:"asset"`
+	Info       string           `json:"info"`
+	Time       int64            `json:"time"`
+	TranID     int64            `json:"tranId"`
+	TradeID    string           `json:"tradeId"`
+}
+
+// FutureIncomeQuery is the filters of the income history, zero values are not sent
+type FutureIncomeQuery struct {
+	Symbol     string
+	IncomeType FutureIncomeType
+	StartTime  int64
+	EndTime    int64
+	Limit      int
+}
+
+// FutureTrade is a futures account trade
+type FutureTrade struct {
+	Buyer           bool            `json:"buyer"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	ID              int64           `json:"id"`
+	Maker           bool            `json:"maker"`
+	OrderID         int64           `json:"orderId"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"`
+	QuoteQty        decimal.Decimal `json:"quoteQty"`
+	RealizedPnl     decimal.Decimal `json:"realizedPnl"`
+	Side            string          `json:"side"`
+	PositionSide    string          `json:"positionSide"`
+	Symbol          string          `json:"symbol"`
+	Time            int64           `json:"time"`
+}
+
+// GetFutureAccount return the USD-M futures account with per asset and per position details
+func (bc *Client) GetFutureAccount() (FutureAccount, *FwdData, error) {
+	var (
+		result FutureAccount
+	)
+	requestURL := fmt.Sprintf("%s/fapi/v2/account", bc.futureAPIBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+// GetFutureIncome return the income history, records are sorted by time ascending when StartTime is set
+func (bc *Client) GetFutureIncome(q FutureIncomeQuery) ([]FutureIncome, *FwdData, error) {
+	return bc.getFutureIncome(bc.usdmFutureAPI(), q)
+}
+
+func (bc *Client) getFutureIncome(api futureAPI, q FutureIncomeQuery) ([]FutureIncome, *FwdData, error) {
+	var (
+		result []FutureIncome
+	)
+	requestURL := api.url("v1/income")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "symbol", q.Symbol)
+	rr = withOptionalParam(rr, "incomeType", string(q.IncomeType))
+	rr = withTimeRangeParams(rr, q.StartTime, q.EndTime, q.Limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetFutureUserTrades return the account trades of a symbol, the zero value of filters are not sent
+func (bc *Client) GetFutureUserTrades(symbol string, startTime, endTime, fromID int64, limit int) ([]FutureTrade, *FwdData, error) {
+	return bc.getFutureUserTrades(bc.usdmFutureAPI(), symbol, startTime, endTime, fromID, limit)
+}
+
+func (bc *Client) getFutureUserTrades(api futureAPI, symbol string, startTime, endTime, fromID int64, limit int) ([]FutureTrade, *FwdData, error) {
+	var (
+		result []FutureTrade
+	)
+	requestURL := api.url("v1/userTrades")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol)
+	if fromID != 0 {
+		rr = rr.WithParam("fromId", strconv.FormatInt(fromID, 10))
+	}
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// FutureIncomeIterator page through the income history from the query StartTime to EndTime
+type FutureIncomeIterator struct {
+	fetch    func(q FutureIncomeQuery) ([]FutureIncome, *FwdData, error)
+	query    FutureIncomeQuery
+	page     []FutureIncome
+	lastSeen map[futureIncomeKey]bool
+	done     bool
+	err      error
+}
+
+// futureIncomeKey identify an income record, tranId is only unique within an income type
+type futureIncomeKey struct {
+	incomeType FutureIncomeType
+	tranID     int64
+}
+
+// NewFutureIncomeIterator create an iterator over the income history matching q, q.StartTime should be set so
+// records are returned in time order. q.Limit is the page size and defaults to the maximum
+func (bc *Client) NewFutureIncomeIterator(q FutureIncomeQuery) *FutureIncomeIterator {
+	return newFutureIncomeIterator(bc.GetFutureIncome, q)
+}
+
+func newFutureIncomeIterator(fetch func(q FutureIncomeQuery) ([]FutureIncome, *FwdData, error), q FutureIncomeQuery) *FutureIncomeIterator {
+	if q.Limit <= 0 || q.Limit > maxFutureIncomeLimit {
+		q.Limit = maxFutureIncomeLimit
+	}
+	return &FutureIncomeIterator{
+		fetch: fetch,
+		query: q,
+	}
+}
+
+// Next fetch the next page, it returns false when there is no more record or an error occurred
+func (it *FutureIncomeIterator) Next() bool {
+	for !it.done {
+		records, _, err := it.fetch(it.query)
+		if err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+		if len(records) < it.query.Limit {
+			it.done = true
+		}
+		// the next page starts at the time of the last record, so records already returned at that time are skipped
+		page := make([]FutureIncome, 0, len(records))
+		for _, r := range records {
+			if it.lastSeen[futureIncomeKey{r.IncomeType, r.TranID}] && r.Time == it.query.StartTime {
+				continue
+			}
+			page = append(page, r)
+		}
+		if len(records) > 0 {
+			last := records[len(records)-1].Time
+			if last == it.query.StartTime && len(page) == 0 && !it.done {
+				it.err = fmt.Errorf("more than %d income records at time %d", it.query.Limit, last)
+				it.done = true
+				return false
+			}
+			if it.lastSeen == nil || last != it.query.StartTime {
+				it.lastSeen = make(map[futureIncomeKey]bool)
+			}
+			for _, r := range records {
+				if r.Time == last {
+					it.lastSeen[futureIncomeKey{r.IncomeType, r.TranID}] = true
+				}
+			}
+			it.query.StartTime = last
+		}
+		if len(page) > 0 {
+			it.page = page
+			return true
+		}
+	}
+	return false
+}
+
+// Page return the records fetched by the last call to Next
+func (it *FutureIncomeIterator) Page() []FutureIncome {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *FutureIncomeIterator) Err() error {
+	return it.err
+}
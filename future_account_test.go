@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// newIncomeServer serve records, which must be sorted by time, as binance does: from startTime, limit records at most
+func newIncomeServer(t *testing.T, records []FutureIncome) (*Client, *int) {
+	calls := 0
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fapi/v1/income" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		calls++
+		start, _ := strconv.ParseInt(r.URL.Query().Get("startTime"), 10, 64)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		page := []FutureIncome{}
+		for _, rec := range records {
+			if rec.Time >= start && len(page) < limit {
+				page = append(page, rec)
+			}
+		}
+		writeJSON(t, w, page)
+	})
+	return bc, &calls
+}
+
+func income(incomeType FutureIncomeType, tranID, time int64) FutureIncome {
+	return FutureIncome{Symbol: "BTCUSDT", IncomeType: incomeType, Income: decimal.NewFromInt(1), Asset: "USDT", Time: time, TranID: tranID}
+}
+
+func TestFutureIncomeIterator(t *testing.T) {
+	tests := []struct {
+		name    string
+		records []FutureIncome
+		limit   int
+		wantErr bool
+	}{
+		{
+			name: "pages without boundary overlap",
+			records: []FutureIncome{
+				income(FutureIncomeFundingFee, 1, 100),
+				income(FutureIncomeFundingFee, 2, 200),
+				income(FutureIncomeFundingFee, 3, 300),
+				income(FutureIncomeFundingFee, 4, 400),
+				income(FutureIncomeFundingFee, 5, 500),
+			},
+			limit: 2,
+		},
+		{
+			name: "records at the page boundary time are not repeated",
+			records: []FutureIncome{
+				income(FutureIncomeRealizedPNL, 1, 100),
+				income(FutureIncomeRealizedPNL, 2, 150),
+				income(FutureIncomeRealizedPNL, 3, 200),
+				income(FutureIncomeRealizedPNL, 4, 200),
+				income(FutureIncomeRealizedPNL, 5, 300),
+			},
+			limit: 3,
+		},
+		{
+			name: "same tranId of different types at the boundary time are both kept",
+			records: []FutureIncome{
+				income(FutureIncomeRealizedPNL, 1, 100),
+				income(FutureIncomeRealizedPNL, 2, 150),
+				income(FutureIncomeFundingFee, 7, 200),
+				income(FutureIncomeCommission, 7, 200),
+				income(FutureIncomeFundingFee, 8, 300),
+			},
+			limit: 3,
+		},
+		{
+			name: "more records at a single time than a page",
+			records: []FutureIncome{
+				income(FutureIncomeCommission, 1, 100),
+				income(FutureIncomeCommission, 2, 100),
+				income(FutureIncomeCommission, 3, 100),
+			},
+			limit:   2,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc, _ := newIncomeServer(t, tt.records)
+			it := bc.NewFutureIncomeIterator(FutureIncomeQuery{StartTime: 1, Limit: tt.limit})
+			var got []FutureIncome
+			for it.Next() {
+				got = append(got, it.Page()...)
+			}
+			if (it.Err() != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", it.Err(), tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.records) {
+				t.Fatalf("got %d records, want %d: %+v", len(got), len(tt.records), got)
+			}
+			for i := range got {
+				if got[i].IncomeType != tt.records[i].IncomeType || got[i].TranID != tt.records[i].TranID {
+					t.Fatalf("record %d = %+v, want %+v", i, got[i], tt.records[i])
+				}
+			}
+		})
+	}
+}
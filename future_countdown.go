@@ -0,0 +1,222 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FutureCountdownResult ...
+type FutureCountdownResult struct {
+	Symbol        string `json:"symbol"`
+	CountdownTime int64  `json:"countdownTime,string"`
+}
+
+// SetFutureCountdownCancelAll ask binance to cancel all open orders of symbol when countdown elapses without another
+// call, a zero countdown disable the timer
+func (bc *Client) SetFutureCountdownCancelAll(symbol string, countdown time.Duration) (FutureCountdownResult, *FwdData, error) {
+	var (
+		result FutureCountdownResult
+	)
+	requestURL := bc.usdmFutureAPI().url("v1/countdownCancelAll")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		WithParam("countdownTime", strconv.FormatInt(countdown.Milliseconds(), 10)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// FutureCountdownStatus is the heartbeat state of a symbol registered to a FutureCountdownManager
+type FutureCountdownStatus struct {
+	Symbol      string
+	LastRefresh time.Time
+	LastError   error
+}
+
+// FutureCountdownManager keep refreshing the auto-cancel countdown of registered symbols, if the process hangs or
+// loses connectivity, binance cancels all open orders of these symbols once the countdown elapses
+type FutureCountdownManager struct {
+	bc        *Client
+	countdown time.Duration
+	interval  time.Duration
+
+	mu      sync.Mutex
+	symbols map[string]*FutureCountdownStatus
+	// calls serialize the countdown requests of each symbol, so a heartbeat in flight can not re-arm the countdown
+	// after Unregister disarmed it
+	calls map[string]*sync.Mutex
+	stop  chan struct{}
+	done  chan struct{}
+}
+
+// NewFutureCountdownManager create a manager which sets a countdown for every registered symbol each interval,
+// interval must be shorter than countdown and should be a fraction of it so a few failed heartbeats do not cancel
+// orders
+func (bc *Client) NewFutureCountdownManager(countdown, interval time.Duration) (*FutureCountdownManager, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid heartbeat interval %s", interval)
+	}
+	if interval >= countdown {
+		return nil, fmt.Errorf("heartbeat interval %s must be shorter than the countdown %s", interval, countdown)
+	}
+	return &FutureCountdownManager{
+		bc:        bc,
+		countdown: countdown,
+		interval:  interval,
+		symbols:   make(map[string]*FutureCountdownStatus),
+		calls:     make(map[string]*sync.Mutex),
+	}, nil
+}
+
+// Register add a symbol to the heartbeat, its countdown is set immediately
+func (m *FutureCountdownManager) Register(symbol string) error {
+	m.mu.Lock()
+	if _, ok := m.symbols[symbol]; !ok {
+		m.symbols[symbol] = &FutureCountdownStatus{Symbol: symbol}
+	}
+	m.mu.Unlock()
+	return m.refresh(symbol)
+}
+
+// Unregister remove a symbol from the heartbeat and disable its countdown
+func (m *FutureCountdownManager) Unregister(symbol string) error {
+	call := m.symbolCall(symbol)
+	call.Lock()
+	defer call.Unlock()
+	m.mu.Lock()
+	delete(m.symbols, symbol)
+	m.mu.Unlock()
+	_, _, err := m.bc.SetFutureCountdownCancelAll(symbol, 0)
+	return err
+}
+
+// Start run the heartbeat in background until Stop is called
+func (m *FutureCountdownManager) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+	go m.run(m.stop, m.done)
+}
+
+// Stop end the heartbeat and disable the countdown of every registered symbol, so open orders are kept
+func (m *FutureCountdownManager) Stop() error {
+	m.mu.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+	var failed []string
+	for _, symbol := range m.registered() {
+		call := m.symbolCall(symbol)
+		call.Lock()
+		_, _, err := m.bc.SetFutureCountdownCancelAll(symbol, 0)
+		call.Unlock()
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", symbol, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to cancel countdown, %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// Status return the heartbeat state of every registered symbol
+func (m *FutureCountdownManager) Status() []FutureCountdownStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]FutureCountdownStatus, 0, len(m.symbols))
+	for _, s := range m.symbols {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Symbol < result[j].Symbol
+	})
+	return result
+}
+
+// Healthy return true if the countdown of every registered symbol was refreshed recently enough that it has not elapsed
+func (m *FutureCountdownManager) Healthy() bool {
+	now := time.Now()
+	for _, s := range m.Status() {
+		if now.Sub(s.LastRefresh) >= m.countdown {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *FutureCountdownManager) run(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			for _, symbol := range m.registered() {
+				_ = m.refresh(symbol) // the error is kept in the symbol status
+			}
+		}
+	}
+}
+
+func (m *FutureCountdownManager) refresh(symbol string) error {
+	call := m.symbolCall(symbol)
+	call.Lock()
+	defer call.Unlock()
+	m.mu.Lock()
+	_, ok := m.symbols[symbol]
+	m.mu.Unlock()
+	if !ok { // unregistered since the tick started, its countdown must stay disarmed
+		return nil
+	}
+	_, _, err := m.bc.SetFutureCountdownCancelAll(symbol, m.countdown)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if status, ok := m.symbols[symbol]; ok {
+		status.LastError = err
+		if err == nil {
+			status.LastRefresh = time.Now()
+		}
+	}
+	return err
+}
+
+func (m *FutureCountdownManager) symbolCall(symbol string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	call, ok := m.calls[symbol]
+	if !ok {
+		call = &sync.Mutex{}
+		m.calls[symbol] = call
+	}
+	return call
+}
+
+func (m *FutureCountdownManager) registered() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	symbols := make([]string, 0, len(m.symbols))
+	for symbol := range m.symbols {
+		symbols = append(symbols, symbol)
+	}
+	return symbols
+}
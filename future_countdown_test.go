@@ -0,0 +1,104 @@
+package binance
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewFutureCountdownManager(t *testing.T) {
+	bc := NewClient("key", "secret", "", "", http.DefaultClient)
+	tests := []struct {
+		name      string
+		countdown time.Duration
+		interval  time.Duration
+		wantErr   bool
+	}{
+		{"valid", time.Minute, 10 * time.Second, false},
+		{"zero interval", time.Minute, 0, true},
+		{"negative interval", time.Minute, -time.Second, true},
+		{"interval equal to countdown", time.Minute, time.Minute, true},
+		{"interval longer than countdown", time.Minute, 2 * time.Minute, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := bc.NewFutureCountdownManager(tt.countdown, tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && m == nil {
+				t.Fatal("nil manager")
+			}
+		})
+	}
+}
+
+func TestFutureCountdownManagerUnregisterRace(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		sent     []string // countdownTime of the answered requests, in answer order
+		block    bool
+		entered  = make(chan struct{}, 1)
+		released = make(chan struct{})
+	)
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		countdown := r.URL.Query().Get("countdownTime")
+		mu.Lock()
+		blocking := block && countdown != "0"
+		mu.Unlock()
+		if blocking {
+			entered <- struct{}{}
+			<-released
+		}
+		mu.Lock()
+		sent = append(sent, countdown)
+		mu.Unlock()
+		writeJSON(t, w, FutureCountdownResult{Symbol: "BTCUSDT"})
+	})
+	m, err := bc.NewFutureCountdownManager(time.Minute, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Register("BTCUSDT"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a heartbeat is in flight when Unregister is called
+	mu.Lock()
+	block = true
+	mu.Unlock()
+	refreshed := make(chan error)
+	go func() { refreshed <- m.refresh("BTCUSDT") }()
+	<-entered
+	unregistered := make(chan error)
+	go func() { unregistered <- m.Unregister("BTCUSDT") }()
+	time.Sleep(50 * time.Millisecond)
+	close(released)
+	if err := <-refreshed; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-unregistered; err != nil {
+		t.Fatal(err)
+	}
+
+	// a tick which took its snapshot before Unregister must not re-arm the countdown
+	if err := m.refresh("BTCUSDT"); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"60000", "60000", "0"}
+	if len(sent) != len(want) {
+		t.Fatalf("sent %v, want %v", sent, want)
+	}
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Fatalf("sent %v, want %v", sent, want)
+		}
+	}
+	if len(m.Status()) != 0 {
+		t.Fatalf("symbol still registered: %v", m.Status())
+	}
+}
@@ -0,0 +1,333 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+)
+
+// FutureExchangeInfo is the trading rules of futures symbols
+type FutureExchangeInfo struct {
+	Timezone   string `json:"timezone"`
+	ServerTime int64  `json:"serverTime"`
+	RateLimits []struct {
+		RateLimitType string `json:"rateLimitType"`
+		Interval      string `json:"interval"`
+		IntervalNum   int64  `json:"intervalNum"`
+		Limit         int64  `json:"limit"`
+	} `json:"rateLimits"`
+	Assets []struct {
+		Asset             string          `json:"asset"`
+		MarginAvailable   bool            `json:"marginAvailable"`
+		AutoAssetExchange decimal.Decimal `json:"autoAssetExchange"`
+	} `json:"assets"`
+	Symbols []FutureSymbol `json:"symbols"`
+}
+
+// FutureSymbol ...
+type FutureSymbol struct {
+	Symbol                string          `json:"symbol"`
+	Pair                  string          `json:"pair"`
+	ContractType          string          `json:"contractType"`
+	DeliveryDate          int64           `json:"deliveryDate"`
+	OnboardDate           int64           `json:"onboardDate"`
+	Status                string          `json:"status"`
+	BaseAsset             string          `json:"baseAsset"`
+	QuoteAsset            string          `json:"quoteAsset"`
+	MarginAsset           string          `json:"marginAsset"`
+	PricePrecision        int             `json:"pricePrecision"`
+	QuantityPrecision     int             `json:"quantityPrecision"`
+	BaseAssetPrecision    int             `json:"baseAssetPrecision"`
+	QuotePrecision        int             `json:"quotePrecision"`
+	UnderlyingType        string          `json:"underlyingType"`
+	TriggerProtect        decimal.Decimal `json:"triggerProtect"`
+	LiquidationFee        decimal.Decimal `json:"liquidationFee"`
+	MarketTakeBound       decimal.Decimal `json:"marketTakeBound"`
+	MaintMarginPercent    decimal.Decimal `json:"maintMarginPercent"`
+	RequiredMarginPercent decimal.Decimal `json:"requiredMarginPercent"`
+	OrderTypes            []string        `json:"orderTypes"`
+	TimeInForce           []string        `json:"timeInForce"`
+	Filters               []FutureFilter  `json:"filters"`
+}
+
+// FutureFilter is a trading rule of a futures symbol, only the fields of FilterType are set
+type FutureFilter struct {
+	FilterType        string          `json:"filterType"`
+	MinPrice          decimal.Decimal `json:"minPrice"`
+	MaxPrice          decimal.Decimal `json:"maxPrice"`
+	TickSize          decimal.Decimal `json:"tickSize"`
+	MinQty            decimal.Decimal `json:"minQty"`
+	MaxQty            decimal.Decimal `json:"maxQty"`
+	StepSize          decimal.Decimal `json:"stepSize"`
+	Limit             int64           `json:"limit"`
+	Notional          decimal.Decimal `json:"notional"`
+	MultiplierUp      decimal.Decimal `json:"multiplierUp"`
+	MultiplierDown    decimal.Decimal `json:"multiplierDown"`
+	MultiplierDecimal decimal.Decimal `json:"multiplierDecimal"`
+}
+
+// FuturePremiumIndex is the mark price and funding rate of a symbol
+type FuturePremiumIndex struct {
+	Symbol               string          `json:"symbol"`
+	MarkPrice            decimal.Decimal `json:"markPrice"`
+	IndexPrice           decimal.Decimal `json:"indexPrice"`
+	EstimatedSettlePrice decimal.Decimal `json:"estimatedSettlePrice"`
+	LastFundingRate      decimal.Decimal `json:"lastFundingRate"`
+	InterestRate         decimal.Decimal `json:"interestRate"`
+	NextFundingTime      int64           `json:"nextFundingTime"`
+	Time                 int64           `json:"time"`
+}
+
+// FutureFundingRate is a funding rate history record
+type FutureFundingRate struct {
+	Symbol      string          `json:"symbol"`
+	FundingRate decimal.Decimal `json:"fundingRate"`
+	FundingTime int64           `json:"fundingTime"`
+	MarkPrice   decimal.Decimal `json:"markPrice"`
+}
+
+// FutureFundingInfo is the funding rate cap and interval of symbols with adjusted funding
+type FutureFundingInfo struct {
+	Symbol                   string          `json:"symbol"`
+	AdjustedFundingRateCap   decimal.Decimal `json:"adjustedFundingRateCap"`
+	AdjustedFundingRateFloor decimal.Decimal `json:"adjustedFundingRateFloor"`
+	FundingIntervalHours     int             `json:"fundingIntervalHours"`
+	Disclaimer               bool            `json:"disclaimer"`
+}
+
+// Kline is a candlestick bar
+type Kline struct {
+	OpenTime                 int64
+	Open                     decimal.Decimal
+	High                     decimal.Decimal
+	Low                      decimal.Decimal
+	Close                    decimal.Decimal
+	Volume                   decimal.Decimal
+	CloseTime                int64
+	QuoteAssetVolume         decimal.Decimal
+	NumberOfTrades           int64
+	TakerBuyBaseAssetVolume  decimal.Decimal
+	TakerBuyQuoteAssetVolume decimal.Decimal
+}
+
+// UnmarshalJSON custom unmarshal for kline array
+func (k *Kline) UnmarshalJSON(text []byte) error {
+	var ignore interface{}
+	temp := []interface{}{&k.OpenTime, &k.Open, &k.High, &k.Low, &k.Close, &k.Volume, &k.CloseTime,
+		&k.QuoteAssetVolume, &k.NumberOfTrades, &k.TakerBuyBaseAssetVolume, &k.TakerBuyQuoteAssetVolume, &ignore}
+	return json.Unmarshal(text, &temp)
+}
+
+// KlineQuery is the filters of a kline request, zero values are not sent
+type KlineQuery struct {
+	Interval  string
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// FutureOpenInterest ...
+type FutureOpenInterest struct {
+	Symbol       string          `json:"symbol"`
+	OpenInterest decimal.Decimal `json:"openInterest"`
+	Time         int64           `json:"time"`
+}
+
+// FutureDataQuery is the filters of the futures statistics endpoints, zero values are not sent
+type FutureDataQuery struct {
+	Period    string
+	StartTime int64
+	EndTime   int64
+	Limit     int
+}
+
+// FutureOpenInterestHist ...
+type FutureOpenInterestHist struct {
+	Symbol               string          `json:"symbol"`
+	SumOpenInterest      decimal.Decimal `json:"sumOpenInterest"`
+	SumOpenInterestValue decimal.Decimal `json:"sumOpenInterestValue"`
+	Timestamp            int64           `json:"timestamp"`
+}
+
+// FutureLongShortRatio ...
+type FutureLongShortRatio struct {
+	Symbol         string          `json:"symbol"`
+	LongShortRatio decimal.Decimal `json:"longShortRatio"`
+	LongAccount    decimal.Decimal `json:"longAccount"`
+	ShortAccount   decimal.Decimal `json:"shortAccount"`
+	Timestamp      int64           `json:"timestamp"`
+}
+
+// FutureTakerVolume ...
+type FutureTakerVolume struct {
+	BuySellRatio decimal.Decimal `json:"buySellRatio"`
+	BuyVol       decimal.Decimal `json:"buyVol"`
+	SellVol      decimal.Decimal `json:"sellVol"`
+	Timestamp    int64           `json:"timestamp"`
+}
+
+// GetFutureExchangeInfo return the trading rules of all futures symbols
+func (bc *Client) GetFutureExchangeInfo() (FutureExchangeInfo, *FwdData, error) {
+	var result FutureExchangeInfo
+	req, err := bc.newFutureMarketRequest("/fapi/v1/exchangeInfo")
+	if err != nil {
+		return result, nil, err
+	}
+	fwd, err := bc.doRequest(req.Request(), &result)
+	return result, fwd, err
+}
+
+// GetFuturePremiumIndex return mark price and funding rate, if symbol is empty, all symbols will return
+func (bc *Client) GetFuturePremiumIndex(symbol string) ([]FuturePremiumIndex, *FwdData, error) {
+	req, err := bc.newFutureMarketRequest("/fapi/v1/premiumIndex")
+	if err != nil {
+		return nil, nil, err
+	}
+	if symbol == "" {
+		var result []FuturePremiumIndex
+		fwd, err := bc.doRequest(req.Request(), &result)
+		return result, fwd, err
+	}
+	var result FuturePremiumIndex
+	fwd, err := bc.doRequest(req.WithParam("symbol", symbol).Request(), &result)
+	if err != nil {
+		return nil, fwd, err
+	}
+	return []FuturePremiumIndex{result}, fwd, err
+}
+
+// GetFutureFundingRateHistory return funding rate history, the zero value of filters are not sent
+func (bc *Client) GetFutureFundingRateHistory(symbol string, startTime, endTime int64, limit int) ([]FutureFundingRate, *FwdData, error) {
+	var result []FutureFundingRate
+	req, err := bc.newFutureMarketRequest("/fapi/v1/fundingRate")
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withTimeRangeParams(withOptionalParam(req, "symbol", symbol), startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.Request(), &result)
+	return result, fwd, err
+}
+
+// GetFutureFundingInfo return funding info of symbols with adjusted funding rate cap, floor or interval
+func (bc *Client) GetFutureFundingInfo() ([]FutureFundingInfo, *FwdData, error) {
+	var result []FutureFundingInfo
+	req, err := bc.newFutureMarketRequest("/fapi/v1/fundingInfo")
+	if err != nil {
+		return result, nil, err
+	}
+	fwd, err := bc.doRequest(req.Request(), &result)
+	return result, fwd, err
+}
+
+// GetFutureKlines return klines of a symbol
+func (bc *Client) GetFutureKlines(symbol string, q KlineQuery) ([]Kline, *FwdData, error) {
+	req, err := bc.newFutureMarketRequest("/fapi/v1/klines")
+	if err != nil {
+		return nil, nil, err
+	}
+	return bc.getFutureKlines(req.WithParam("symbol", symbol), q)
+}
+
+// GetFutureContinuousKlines return klines of a pair and contract type (PERPETUAL, CURRENT_QUARTER, NEXT_QUARTER)
+func (bc *Client) GetFutureContinuousKlines(pair, contractType string, q KlineQuery) ([]Kline, *FwdData, error) {
+	req, err := bc.newFutureMarketRequest("/fapi/v1/continuousKlines")
+	if err != nil {
+		return nil, nil, err
+	}
+	return bc.getFutureKlines(req.WithParam("pair", pair).WithParam("contractType", contractType), q)
+}
+
+// GetFutureIndexPriceKlines return index price klines of a pair, volume fields are not set
+func (bc *Client) GetFutureIndexPriceKlines(pair string, q KlineQuery) ([]Kline, *FwdData, error) {
+	req, err := bc.newFutureMarketRequest("/fapi/v1/indexPriceKlines")
+	if err != nil {
+		return nil, nil, err
+	}
+	return bc.getFutureKlines(req.WithParam("pair", pair), q)
+}
+
+// GetFutureMarkPriceKlines return mark price klines of a symbol, volume fields are not set
+func (bc *Client) GetFutureMarkPriceKlines(symbol string, q KlineQuery) ([]Kline, *FwdData, error) {
+	req, err := bc.newFutureMarketRequest("/fapi/v1/markPriceKlines")
+	if err != nil {
+		return nil, nil, err
+	}
+	return bc.getFutureKlines(req.WithParam("symbol", symbol), q)
+}
+
+func (bc *Client) getFutureKlines(rb *RequestBuilder, q KlineQuery) ([]Kline, *FwdData, error) {
+	var result []Kline
+	fwd, err := bc.doRequest(q.withParams(rb).Request(), &result)
+	return result, fwd, err
+}
+
+// GetFutureOpenInterest return present open interest of a symbol
+func (bc *Client) GetFutureOpenInterest(symbol string) (FutureOpenInterest, *FwdData, error) {
+	var result FutureOpenInterest
+	req, err := bc.newFutureMarketRequest("/fapi/v1/openInterest")
+	if err != nil {
+		return result, nil, err
+	}
+	fwd, err := bc.doRequest(req.WithParam("symbol", symbol).Request(), &result)
+	return result, fwd, err
+}
+
+// GetFutureOpenInterestHist return open interest statistics of a symbol
+func (bc *Client) GetFutureOpenInterestHist(symbol string, q FutureDataQuery) ([]FutureOpenInterestHist, *FwdData, error) {
+	var result []FutureOpenInterestHist
+	fwd, err := bc.doFutureDataRequest("/futures/data/openInterestHist", symbol, q, &result)
+	return result, fwd, err
+}
+
+// GetFutureTopLongShortAccountRatio return long/short account ratio of top traders
+func (bc *Client) GetFutureTopLongShortAccountRatio(symbol string, q FutureDataQuery) ([]FutureLongShortRatio, *FwdData, error) {
+	var result []FutureLongShortRatio
+	fwd, err := bc.doFutureDataRequest("/futures/data/topLongShortAccountRatio", symbol, q, &result)
+	return result, fwd, err
+}
+
+// GetFutureTopLongShortPositionRatio return long/short position ratio of top traders
+func (bc *Client) GetFutureTopLongShortPositionRatio(symbol string, q FutureDataQuery) ([]FutureLongShortRatio, *FwdData, error) {
+	var result []FutureLongShortRatio
+	fwd, err := bc.doFutureDataRequest("/futures/data/topLongShortPositionRatio", symbol, q, &result)
+	return result, fwd, err
+}
+
+// GetFutureGlobalLongShortAccountRatio return long/short account ratio of all traders
+func (bc *Client) GetFutureGlobalLongShortAccountRatio(symbol string, q FutureDataQuery) ([]FutureLongShortRatio, *FwdData, error) {
+	var result []FutureLongShortRatio
+	fwd, err := bc.doFutureDataRequest("/futures/data/globalLongShortAccountRatio", symbol, q, &result)
+	return result, fwd, err
+}
+
+// GetFutureTakerLongShortRatio return taker buy/sell volume of a symbol
+func (bc *Client) GetFutureTakerLongShortRatio(symbol string, q FutureDataQuery) ([]FutureTakerVolume, *FwdData, error) {
+	var result []FutureTakerVolume
+	fwd, err := bc.doFutureDataRequest("/futures/data/takerlongshortRatio", symbol, q, &result)
+	return result, fwd, err
+}
+
+func (q KlineQuery) withParams(rb *RequestBuilder) *RequestBuilder {
+	return withTimeRangeParams(rb.WithParam("interval", q.Interval), q.StartTime, q.EndTime, q.Limit)
+}
+
+func (q FutureDataQuery) withParams(rb *RequestBuilder) *RequestBuilder {
+	return withTimeRangeParams(rb.WithParam("period", q.Period), q.StartTime, q.EndTime, q.Limit)
+}
+
+// doFutureDataRequest send a futures statistics request of symbol
+func (bc *Client) doFutureDataRequest(apiPath, symbol string, q FutureDataQuery, result interface{}) (*FwdData, error) {
+	req, err := bc.newFutureMarketRequest(apiPath)
+	if err != nil {
+		return nil, err
+	}
+	return bc.doRequest(q.withParams(req.WithParam("symbol", symbol)).Request(), result)
+}
+
+// newFutureMarketRequest create an unsigned GET request to a public futures endpoint
+func (bc *Client) newFutureMarketRequest(apiPath string) (*RequestBuilder, error) {
+	requestURL := fmt.Sprintf("%s%s", bc.futureAPIBaseURL, apiPath)
+	return NewRequestBuilder(http.MethodGet, requestURL, nil)
+}
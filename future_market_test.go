@@ -0,0 +1,111 @@
+package binance
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestKlineUnmarshalJSON(t *testing.T) {
+	d := decimal.RequireFromString
+	tests := []struct {
+		name    string
+		data    string
+		want    Kline
+		wantErr bool
+	}{
+		{
+			name: "full kline",
+			data: `[1499040000000,"0.01634790","0.80000000","0.01575800","0.01577100","148976.11427815",1499644799999,"2434.19055334",308,"1756.87402397","28.46694368","0"]`,
+			want: Kline{
+				OpenTime:                 1499040000000,
+				Open:                     d("0.0163479"),
+				High:                     d("0.8"),
+				Low:                      d("0.015758"),
+				Close:                    d("0.015771"),
+				Volume:                   d("148976.11427815"),
+				CloseTime:                1499644799999,
+				QuoteAssetVolume:         d("2434.19055334"),
+				NumberOfTrades:           308,
+				TakerBuyBaseAssetVolume:  d("1756.87402397"),
+				TakerBuyQuoteAssetVolume: d("28.46694368"),
+			},
+		},
+		{
+			name: "short kline",
+			data: `[1591256400000,"9653.69440000","9653.69640000","9651.38600000","9651.55200000"]`,
+			want: Kline{
+				OpenTime: 1591256400000,
+				Open:     d("9653.6944"),
+				High:     d("9653.6964"),
+				Low:      d("9651.386"),
+				Close:    d("9651.552"),
+			},
+		},
+		{name: "invalid open time", data: `["soon","1","1","1","1"]`, wantErr: true},
+		{name: "invalid price", data: `[1591256400000,"abc","1","1","1"]`, wantErr: true},
+		{name: "not an array", data: `{"openTime":1591256400000}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Kline
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.OpenTime != tt.want.OpenTime || got.CloseTime != tt.want.CloseTime ||
+				got.NumberOfTrades != tt.want.NumberOfTrades {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+			for _, v := range []struct {
+				name      string
+				got, want decimal.Decimal
+			}{
+				{"open", got.Open, tt.want.Open},
+				{"high", got.High, tt.want.High},
+				{"low", got.Low, tt.want.Low},
+				{"close", got.Close, tt.want.Close},
+				{"volume", got.Volume, tt.want.Volume},
+				{"quote asset volume", got.QuoteAssetVolume, tt.want.QuoteAssetVolume},
+				{"taker buy base asset volume", got.TakerBuyBaseAssetVolume, tt.want.TakerBuyBaseAssetVolume},
+				{"taker buy quote asset volume", got.TakerBuyQuoteAssetVolume, tt.want.TakerBuyQuoteAssetVolume},
+			} {
+				if !v.got.Equal(v.want) {
+					t.Errorf("%s = %s, want %s", v.name, v.got, v.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetFutureContinuousKlines(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fapi/v1/continuousKlines" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		want := map[string][]string{
+			"pair":         {"BTCUSDT"},
+			"contractType": {"PERPETUAL"},
+			"interval":     {"1h"},
+			"startTime":    {"1000"},
+			"limit":        {"2"},
+		}
+		if got := map[string][]string(r.URL.Query()); !reflect.DeepEqual(got, want) {
+			t.Errorf("params = %v, want %v", got, want)
+		}
+		_, _ = w.Write([]byte(`[[1000,"1","2","0.5","1.5","10",3599999,"15",3,"5","7.5","0"]]`))
+	})
+	klines, _, err := bc.GetFutureContinuousKlines("BTCUSDT", "PERPETUAL", KlineQuery{Interval: "1h", StartTime: 1000, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(klines) != 1 || klines[0].OpenTime != 1000 || !klines[0].Close.Equal(decimal.RequireFromString("1.5")) {
+		t.Errorf("GetFutureContinuousKlines() = %+v", klines)
+	}
+}
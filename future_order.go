@@ -0,0 +1,328 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	maxFutureBatchOrders  = 5
+	maxFutureBatchCancels = 10
+)
+
+// FutureOrderRequest is the parameters to place a futures order, zero values are not sent
+type FutureOrderRequest struct {
+	Symbol                  string
+	Side                    string
+	PositionSide            string
+	Type                    string
+	TimeInForce             string
+	Quantity                decimal.Decimal
+	Price                   decimal.Decimal
+	StopPrice               decimal.Decimal
+	ActivationPrice         decimal.Decimal
+	CallbackRate            decimal.Decimal
+	ReduceOnly              bool
+	ClosePosition           bool
+	PriceProtect            bool
+	NewClientOrderID        string
+	WorkingType             string
+	NewOrderRespType        string
+	PriceMatch              string
+	SelfTradePreventionMode string
+	GoodTillDate            int64
+}
+
+// params return the parameters of the order, zero values are omitted
+func (r FutureOrderRequest) params() url.Values {
+	params := url.Values{}
+	params.Set("symbol", r.Symbol)
+	params.Set("side", r.Side)
+	params.Set("type", r.Type)
+	setOptionalParam(params, "positionSide", r.PositionSide)
+	setOptionalParam(params, "timeInForce", r.TimeInForce)
+	setDecimalParam(params, "quantity", r.Quantity)
+	setDecimalParam(params, "price", r.Price)
+	setDecimalParam(params, "stopPrice", r.StopPrice)
+	setDecimalParam(params, "activationPrice", r.ActivationPrice)
+	setDecimalParam(params, "callbackRate", r.CallbackRate)
+	if r.ReduceOnly {
+		params.Set("reduceOnly", strconv.FormatBool(r.ReduceOnly))
+	}
+	if r.ClosePosition {
+		params.Set("closePosition", strconv.FormatBool(r.ClosePosition))
+	}
+	if r.PriceProtect {
+		params.Set("priceProtect", strconv.FormatBool(r.PriceProtect))
+	}
+	setOptionalParam(params, "newClientOrderId", r.NewClientOrderID)
+	setOptionalParam(params, "workingType", r.WorkingType)
+	setOptionalParam(params, "newOrderRespType", r.NewOrderRespType)
+	setOptionalParam(params, "priceMatch", r.PriceMatch)
+	setOptionalParam(params, "selfTradePreventionMode", r.SelfTradePreventionMode)
+	if r.GoodTillDate != 0 {
+		params.Set("goodTillDate", strconv.FormatInt(r.GoodTillDate, 10))
+	}
+	return params
+}
+
+// FutureModifyOrderRequest is the parameters to modify a futures limit order,
+// either OrderID or OrigClientOrderID must be set and exactly one of Price and PriceMatch
+type FutureModifyOrderRequest struct {
+	Symbol            string
+	OrderID           int64
+	OrigClientOrderID string
+	Side              string
+	Quantity          decimal.Decimal
+	Price             decimal.Decimal
+	PriceMatch        string
+}
+
+// FutureBatchOrderResult is an item of a batch order response, it is either an order or an error
+type FutureBatchOrderResult struct {
+	FutureOrder
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Err return the error of the item if binance rejected it
+func (r FutureBatchOrderResult) Err() error {
+	if r.Code == 0 {
+		return nil
+	}
+	return newAPIError(r.Code, r.Msg)
+}
+
+// CancelAllFutureOrdersResult ...
+type CancelAllFutureOrdersResult struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// PlaceFutureOrder place a new futures order
+func (bc *Client) PlaceFutureOrder(r FutureOrderRequest) (FutureOrder, *FwdData, error) {
+	return bc.placeFutureOrder(bc.usdmFutureAPI(), r)
+}
+
+func (bc *Client) placeFutureOrder(api futureAPI, r FutureOrderRequest) (FutureOrder, *FwdData, error) {
+	var (
+		result FutureOrder
+	)
+	requestURL := api.url("v1/order")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withParams(req.WithHeader(apiKeyHeader, bc.apiKey), r.params())
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, api.hasTestOrder())
+	return result, fwd, err
+}
+
+// PlaceFutureBatchOrders place up to 5 futures orders in one request, the result items have the same order as the requests
+func (bc *Client) PlaceFutureBatchOrders(orders []FutureOrderRequest) ([]FutureBatchOrderResult, *FwdData, error) {
+	return bc.placeFutureBatchOrders(bc.usdmFutureAPI(), orders)
+}
+
+func (bc *Client) placeFutureBatchOrders(api futureAPI, orders []FutureOrderRequest) ([]FutureBatchOrderResult, *FwdData, error) {
+	var (
+		result []FutureBatchOrderResult
+	)
+	if len(orders) == 0 || len(orders) > maxFutureBatchOrders {
+		return nil, nil, fmt.Errorf("batch must have 1 to %d orders, got %d", maxFutureBatchOrders, len(orders))
+	}
+	params := make([]map[string]string, 0, len(orders))
+	for _, o := range orders {
+		values := o.params()
+		order := make(map[string]string, len(values))
+		for key := range values {
+			order[key] = values.Get(key)
+		}
+		params = append(params, order)
+	}
+	batchOrders, err := json.Marshal(params)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode batch orders, %w", err)
+	}
+	requestURL := api.url("v1/batchOrders")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("batchOrders", string(batchOrders)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// ModifyFutureOrder modify the price and quantity of an open limit order
+func (bc *Client) ModifyFutureOrder(r FutureModifyOrderRequest) (FutureOrder, *FwdData, error) {
+	return bc.modifyFutureOrder(bc.usdmFutureAPI(), r)
+}
+
+func (bc *Client) modifyFutureOrder(api futureAPI, r FutureModifyOrderRequest) (FutureOrder, *FwdData, error) {
+	var (
+		result FutureOrder
+	)
+	if r.Price.IsZero() == (r.PriceMatch == "") {
+		return result, nil, fmt.Errorf("exactly one of price and price match must be set")
+	}
+	requestURL := api.url("v1/order")
+	req, err := NewRequestBuilder(http.MethodPut, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", r.Symbol).
+		WithParam("side", r.Side).
+		WithParam("quantity", r.Quantity.String())
+	rr = withDecimalParam(rr, "price", r.Price)
+	rr = withOptionalParam(rr, "priceMatch", r.PriceMatch)
+	rr = withOrderID(rr, r.OrderID, r.OrigClientOrderID)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// CancelFutureOrder cancel a futures order by orderID or origClientOrderID
+func (bc *Client) CancelFutureOrder(symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	return bc.cancelFutureOrder(bc.usdmFutureAPI(), symbol, orderID, origClientOrderID)
+}
+
+func (bc *Client) cancelFutureOrder(api futureAPI, symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	var (
+		result FutureOrder
+	)
+	requestURL := api.url("v1/order")
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOrderID(req.WithHeader(apiKeyHeader, bc.apiKey).WithParam("symbol", symbol), orderID, origClientOrderID)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// CancelAllFutureOrders cancel all open futures orders of a symbol
+func (bc *Client) CancelAllFutureOrders(symbol string) (CancelAllFutureOrdersResult, *FwdData, error) {
+	return bc.cancelAllFutureOrders(bc.usdmFutureAPI(), symbol)
+}
+
+func (bc *Client) cancelAllFutureOrders(api futureAPI, symbol string) (CancelAllFutureOrdersResult, *FwdData, error) {
+	var (
+		result CancelAllFutureOrdersResult
+	)
+	requestURL := api.url("v1/allOpenOrders")
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// CancelFutureBatchOrders cancel up to 10 futures orders of a symbol, exactly one of orderIDs and origClientOrderIDs must be set
+func (bc *Client) CancelFutureBatchOrders(symbol string, orderIDs []int64, origClientOrderIDs []string) ([]FutureBatchOrderResult, *FwdData, error) {
+	return bc.cancelFutureBatchOrders(bc.usdmFutureAPI(), symbol, orderIDs, origClientOrderIDs)
+}
+
+func (bc *Client) cancelFutureBatchOrders(api futureAPI, symbol string, orderIDs []int64, origClientOrderIDs []string) ([]FutureBatchOrderResult, *FwdData, error) {
+	var (
+		result []FutureBatchOrderResult
+	)
+	if (len(orderIDs) == 0) == (len(origClientOrderIDs) == 0) {
+		return nil, nil, fmt.Errorf("exactly one of order ids and client order ids must be set")
+	}
+	if n := len(orderIDs) + len(origClientOrderIDs); n > maxFutureBatchCancels {
+		return nil, nil, fmt.Errorf("batch cancel must have at most %d orders, got %d", maxFutureBatchCancels, n)
+	}
+	requestURL := api.url("v1/batchOrders")
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol)
+	if len(orderIDs) > 0 {
+		ids := make([]string, 0, len(orderIDs))
+		for _, id := range orderIDs {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		rr = rr.WithParam("orderIdList", "["+strings.Join(ids, ",")+"]")
+	}
+	if len(origClientOrderIDs) > 0 {
+		ids, err := json.Marshal(origClientOrderIDs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode client order ids, %w", err)
+		}
+		rr = rr.WithParam("origClientOrderIdList", string(ids))
+	}
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// GetFutureOrder query a futures order by orderID or origClientOrderID
+func (bc *Client) GetFutureOrder(symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	return bc.getFutureOrder(bc.usdmFutureAPI(), symbol, orderID, origClientOrderID)
+}
+
+func (bc *Client) getFutureOrder(api futureAPI, symbol string, orderID int64, origClientOrderID string) (FutureOrder, *FwdData, error) {
+	var (
+		result FutureOrder
+	)
+	requestURL := api.url("v1/order")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withOrderID(req.WithHeader(apiKeyHeader, bc.apiKey).WithParam("symbol", symbol), orderID, origClientOrderID)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetFutureOpenOrders return open futures orders, if symbol is empty, open orders of all symbols will return
+func (bc *Client) GetFutureOpenOrders(symbol string) ([]FutureOrder, *FwdData, error) {
+	return bc.getFutureOpenOrders(bc.usdmFutureAPI(), symbol)
+}
+
+func (bc *Client) getFutureOpenOrders(api futureAPI, symbol string) ([]FutureOrder, *FwdData, error) {
+	var (
+		result []FutureOrder
+	)
+	requestURL := api.url("v1/openOrders")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withOptionalParam(req.WithHeader(apiKeyHeader, bc.apiKey), "symbol", symbol)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetFutureAllOrders return all futures orders of a symbol, the zero value of filters are not sent
+func (bc *Client) GetFutureAllOrders(symbol string, orderID, startTime, endTime int64, limit int) ([]FutureOrder, *FwdData, error) {
+	return bc.getFutureAllOrders(bc.usdmFutureAPI(), symbol, orderID, startTime, endTime, limit)
+}
+
+func (bc *Client) getFutureAllOrders(api futureAPI, symbol string, orderID, startTime, endTime int64, limit int) ([]FutureOrder, *FwdData, error) {
+	var (
+		result []FutureOrder
+	)
+	requestURL := api.url("v1/allOrders")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withOrderID(req.WithHeader(apiKeyHeader, bc.apiKey).WithParam("symbol", symbol), orderID, "")
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
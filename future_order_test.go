@@ -0,0 +1,201 @@
+package binance
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestModifyFutureOrder(t *testing.T) {
+	tests := []struct {
+		name           string
+		price          decimal.Decimal
+		priceMatch     string
+		wantPrice      string
+		wantPriceMatch string
+		wantErr        bool
+	}{
+		{name: "price", price: decimal.RequireFromString("101.5"), wantPrice: "101.5"},
+		{name: "price match", priceMatch: "QUEUE", wantPriceMatch: "QUEUE"},
+		{name: "both", price: decimal.RequireFromString("101.5"), priceMatch: "QUEUE", wantErr: true},
+		{name: "neither", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if err := r.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				if r.Method != http.MethodPut || r.URL.Path != "/fapi/v1/order" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.Form.Get("price"); got != tt.wantPrice {
+					t.Errorf("price = %q, want %q", got, tt.wantPrice)
+				}
+				if got := r.Form.Get("priceMatch"); got != tt.wantPriceMatch {
+					t.Errorf("priceMatch = %q, want %q", got, tt.wantPriceMatch)
+				}
+				if got := r.Form.Get("orderId"); got != "42" {
+					t.Errorf("orderId = %q, want 42", got)
+				}
+				writeJSON(t, w, map[string]interface{}{"orderId": 42})
+			})
+			_, _, err := bc.ModifyFutureOrder(FutureModifyOrderRequest{
+				Symbol:     "BTCUSDT",
+				OrderID:    42,
+				Side:       "BUY",
+				Quantity:   decimal.NewFromInt(1),
+				Price:      tt.price,
+				PriceMatch: tt.priceMatch,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ModifyFutureOrder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			wantCalls := 1
+			if tt.wantErr {
+				wantCalls = 0
+			}
+			if calls != wantCalls {
+				t.Errorf("%d requests sent, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
+
+func TestPlaceFutureBatchOrders(t *testing.T) {
+	orders := []FutureOrderRequest{
+		{
+			Symbol:      "BTCUSDT",
+			Side:        "BUY",
+			Type:        "LIMIT",
+			TimeInForce: "GTC",
+			Quantity:    decimal.RequireFromString("0.01"),
+			Price:       decimal.RequireFromString("30000"),
+			ReduceOnly:  true,
+		},
+		{Symbol: "ETHUSDT", Side: "SELL", Type: "MARKET", Quantity: decimal.NewFromInt(1), GoodTillDate: 1700000000000},
+	}
+	want := []map[string]string{
+		{"symbol": "BTCUSDT", "side": "BUY", "type": "LIMIT", "timeInForce": "GTC", "quantity": "0.01", "price": "30000", "reduceOnly": "true"},
+		{"symbol": "ETHUSDT", "side": "SELL", "type": "MARKET", "quantity": "1", "goodTillDate": "1700000000000"},
+	}
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fapi/v1/batchOrders" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		var got []map[string]string
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("batchOrders")), &got); err != nil {
+			t.Fatalf("invalid batchOrders: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("batchOrders = %v, want %v", got, want)
+		}
+		writeJSON(t, w, []interface{}{
+			map[string]interface{}{"orderId": 1},
+			map[string]interface{}{"code": -2019, "msg": "Margin is insufficient."},
+		})
+	})
+	result, _, err := bc.PlaceFutureBatchOrders(orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result) != 2 || result[0].Err() != nil || result[1].Err() == nil {
+		t.Errorf("PlaceFutureBatchOrders() = %+v, want a placed order then a rejected one", result)
+	}
+}
+
+func TestFutureBatchOrdersLimits(t *testing.T) {
+	order := FutureOrderRequest{Symbol: "BTCUSDT", Side: "BUY", Type: "MARKET", Quantity: decimal.NewFromInt(1)}
+	ids := func(n int) []int64 {
+		ids := make([]int64, n)
+		for i := range ids {
+			ids[i] = int64(i + 1)
+		}
+		return ids
+	}
+	tests := []struct {
+		name    string
+		call    func(bc *Client) error
+		wantErr bool
+	}{
+		{
+			name: "no orders",
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceFutureBatchOrders(nil)
+				return err
+			},
+			wantErr: true,
+		},
+		{
+			name: "5 orders",
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceFutureBatchOrders([]FutureOrderRequest{order, order, order, order, order})
+				return err
+			},
+		},
+		{
+			name: "6 orders",
+			call: func(bc *Client) error {
+				_, _, err := bc.PlaceFutureBatchOrders([]FutureOrderRequest{order, order, order, order, order, order})
+				return err
+			},
+			wantErr: true,
+		},
+		{
+			name: "no ids to cancel",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelFutureBatchOrders("BTCUSDT", nil, nil)
+				return err
+			},
+			wantErr: true,
+		},
+		{
+			name: "both order ids and client order ids",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelFutureBatchOrders("BTCUSDT", ids(1), []string{"a"})
+				return err
+			},
+			wantErr: true,
+		},
+		{
+			name: "10 ids to cancel",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelFutureBatchOrders("BTCUSDT", ids(10), nil)
+				return err
+			},
+		},
+		{
+			name: "11 ids to cancel",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelFutureBatchOrders("BTCUSDT", ids(11), nil)
+				return err
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				writeJSON(t, w, []interface{}{})
+			})
+			err := tt.call(bc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			wantCalls := 1
+			if tt.wantErr {
+				wantCalls = 0
+			}
+			if calls != wantCalls {
+				t.Errorf("%d requests sent, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// Binance error codes returned when a futures setting already has the requested value
+const (
+	ErrCodeNoNeedToChangeMarginType      = -4046
+	ErrCodeNoNeedToChangePositionSide    = -4059
+	ErrCodeNoNeedToChangeMultiAssetsMode = -4171
+)
+
+// FutureMarginType is the margin type of a futures symbol
+type FutureMarginType string
+
+const (
+	FutureMarginTypeIsolated FutureMarginType = "ISOLATED"
+	FutureMarginTypeCrossed  FutureMarginType = "CROSSED"
+)
+
+// FutureLeverageResult ...
+type FutureLeverageResult struct {
+	Leverage         int             `json:"leverage"`
+	MaxNotionalValue decimal.Decimal `json:"maxNotionalValue"`
+	MaxQty           decimal.Decimal `json:"maxQty"` // COIN-M only
+	Symbol           string          `json:"symbol"`
+}
+
+// FutureSettingResult is returned by futures endpoints that change an account setting
+type FutureSettingResult struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// FuturePositionMarginResult ...
+type FuturePositionMarginResult struct {
+	Amount decimal.Decimal `json:"amount"`
+	Code   int             `json:"code"`
+	Msg    string          `json:"msg"`
+	Type   int             `json:"type"`
+}
+
+// ChangeFutureLeverage change the initial leverage of a symbol
+func (bc *Client) ChangeFutureLeverage(symbol string, leverage int) (FutureLeverageResult, *FwdData, error) {
+	return bc.changeFutureLeverage(bc.usdmFutureAPI(), symbol, leverage)
+}
+
+func (bc *Client) changeFutureLeverage(api futureAPI, symbol string, leverage int) (FutureLeverageResult, *FwdData, error) {
+	var (
+		result FutureLeverageResult
+	)
+	requestURL := api.url("v1/leverage")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		WithParam("leverage", strconv.Itoa(leverage)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// ChangeFutureMarginType change the margin type of a symbol, it is not an error if the symbol already uses marginType
+func (bc *Client) ChangeFutureMarginType(symbol string, marginType FutureMarginType) (FutureSettingResult, *FwdData, error) {
+	return bc.changeFutureMarginType(bc.usdmFutureAPI(), symbol, marginType)
+}
+
+func (bc *Client) changeFutureMarginType(api futureAPI, symbol string, marginType FutureMarginType) (FutureSettingResult, *FwdData, error) {
+	var (
+		result FutureSettingResult
+	)
+	requestURL := api.url("v1/marginType")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		WithParam("marginType", string(marginType)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return ignoreFutureNoopError(result, fwd, err, ErrCodeNoNeedToChangeMarginType)
+}
+
+// GetFuturePositionMode return true if the account uses hedge mode (dual side position)
+func (bc *Client) GetFuturePositionMode() (bool, *FwdData, error) {
+	return bc.getFuturePositionMode(bc.usdmFutureAPI())
+}
+
+func (bc *Client) getFuturePositionMode(api futureAPI) (bool, *FwdData, error) {
+	var (
+		result struct {
+			DualSidePosition bool `json:"dualSidePosition"`
+		}
+	)
+	requestURL := api.url("v1/positionSide/dual")
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return false, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result.DualSidePosition, fwd, err
+}
+
+// ChangeFuturePositionMode switch between hedge mode (dualSide = true) and one-way mode, it is not an error if the
+// account already uses the requested mode
+func (bc *Client) ChangeFuturePositionMode(dualSide bool) (FutureSettingResult, *FwdData, error) {
+	return bc.changeFuturePositionMode(bc.usdmFutureAPI(), dualSide)
+}
+
+func (bc *Client) changeFuturePositionMode(api futureAPI, dualSide bool) (FutureSettingResult, *FwdData, error) {
+	var (
+		result FutureSettingResult
+	)
+	requestURL := api.url("v1/positionSide/dual")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("dualSidePosition", strconv.FormatBool(dualSide)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return ignoreFutureNoopError(result, fwd, err, ErrCodeNoNeedToChangePositionSide)
+}
+
+// GetFutureMultiAssetsMode return true if the account uses multi-assets mode
+func (bc *Client) GetFutureMultiAssetsMode() (bool, *FwdData, error) {
+	var (
+		result struct {
+			MultiAssetsMargin bool `json:"multiAssetsMargin"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/fapi/v1/multiAssetsMargin", bc.futureAPIBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return false, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).SignedRequest(bc.secretKey)
+	fwd, err := bc.doRequest(rr, &result)
+	return result.MultiAssetsMargin, fwd, err
+}
+
+// ChangeFutureMultiAssetsMode switch between multi-assets mode and single-asset mode, it is not an error if the
+// account already uses the requested mode
+func (bc *Client) ChangeFutureMultiAssetsMode(multiAssets bool) (FutureSettingResult, *FwdData, error) {
+	var (
+		result FutureSettingResult
+	)
+	requestURL := fmt.Sprintf("%s/fapi/v1/multiAssetsMargin", bc.futureAPIBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("multiAssetsMargin", strconv.FormatBool(multiAssets)).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return ignoreFutureNoopError(result, fwd, err, ErrCodeNoNeedToChangeMultiAssetsMode)
+}
+
+// AddFuturePositionMargin add margin to an isolated position, positionSide can be empty in one-way mode
+func (bc *Client) AddFuturePositionMargin(symbol, positionSide string, amount decimal.Decimal) (FuturePositionMarginResult, *FwdData, error) {
+	return bc.modifyFuturePositionMargin(bc.usdmFutureAPI(), symbol, positionSide, amount, "1")
+}
+
+// ReduceFuturePositionMargin remove margin from an isolated position, positionSide can be empty in one-way mode
+func (bc *Client) ReduceFuturePositionMargin(symbol, positionSide string, amount decimal.Decimal) (FuturePositionMarginResult, *FwdData, error) {
+	return bc.modifyFuturePositionMargin(bc.usdmFutureAPI(), symbol, positionSide, amount, "2")
+}
+
+func (bc *Client) modifyFuturePositionMargin(api futureAPI, symbol, positionSide string, amount decimal.Decimal, modifyType string) (FuturePositionMarginResult, *FwdData, error) {
+	var (
+		result FuturePositionMarginResult
+	)
+	if positionSide == "" {
+		positionSide = "BOTH"
+	}
+	requestURL := api.url("v1/positionMargin")
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("symbol", symbol).
+		WithParam("positionSide", positionSide).
+		WithParam("amount", amount.String()).
+		WithParam("type", modifyType).
+		SignedRequest(bc.secretKey)
+	fwd, err := bc.doMutatingRequest(rr, &result, false)
+	return result, fwd, err
+}
+
+// ignoreFutureNoopError turn the error binance returns when a setting already has the requested value into a success
+func ignoreFutureNoopError(result FutureSettingResult, fwd *FwdData, err error, noopCode int) (FutureSettingResult, *FwdData, error) {
+	if apiErr, ok := ToAPIError(err); ok && apiErr.Code == noopCode {
+		return FutureSettingResult{Code: 200, Msg: apiErr.Msg}, fwd, nil
+	}
+	return result, fwd, err
+}
@@ -0,0 +1,86 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestChangeFutureSettingNoopErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		code     int
+		call     func(bc *Client) (FutureSettingResult, error)
+		wantErr  bool
+		wantCode int
+	}{
+		{
+			name: "margin type already set",
+			path: "/fapi/v1/marginType",
+			code: ErrCodeNoNeedToChangeMarginType,
+			call: func(bc *Client) (FutureSettingResult, error) {
+				result, _, err := bc.ChangeFutureMarginType("BTCUSDT", FutureMarginTypeIsolated)
+				return result, err
+			},
+			wantCode: 200,
+		},
+		{
+			name: "position mode already set",
+			path: "/fapi/v1/positionSide/dual",
+			code: ErrCodeNoNeedToChangePositionSide,
+			call: func(bc *Client) (FutureSettingResult, error) {
+				result, _, err := bc.ChangeFuturePositionMode(true)
+				return result, err
+			},
+			wantCode: 200,
+		},
+		{
+			name: "multi-assets mode already set",
+			path: "/fapi/v1/multiAssetsMargin",
+			code: ErrCodeNoNeedToChangeMultiAssetsMode,
+			call: func(bc *Client) (FutureSettingResult, error) {
+				result, _, err := bc.ChangeFutureMultiAssetsMode(false)
+				return result, err
+			},
+			wantCode: 200,
+		},
+		{
+			name: "no-op code of another setting",
+			path: "/fapi/v1/marginType",
+			code: ErrCodeNoNeedToChangePositionSide,
+			call: func(bc *Client) (FutureSettingResult, error) {
+				result, _, err := bc.ChangeFutureMarginType("BTCUSDT", FutureMarginTypeCrossed)
+				return result, err
+			},
+			wantErr: true,
+		},
+		{
+			name: "other error",
+			path: "/fapi/v1/positionSide/dual",
+			code: -4068, // position side cannot be changed if there exists position
+			call: func(bc *Client) (FutureSettingResult, error) {
+				result, _, err := bc.ChangeFuturePositionMode(false)
+				return result, err
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusBadRequest)
+				writeJSON(t, w, map[string]interface{}{"code": tt.code, "msg": "no need to change"})
+			})
+			result, err := tt.call(bc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result.Code != tt.wantCode {
+				t.Errorf("code = %d, want %d", result.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// USDMStreamURL is the websocket base url of USD-M futures streams
+	USDMStreamURL = "wss://fstream.binance.com/ws"
+	// COINMStreamURL is the websocket base url of COIN-M futures streams
+	COINMStreamURL = "wss://dstream.binance.com/ws"
+
+	listenKeyFutureAPI = "v1/listenKey"
+
+	defaultFutureKeepAliveInterval = 30 * time.Minute
+	defaultFutureReconnectDelay    = 5 * time.Second
+)
+
+// errListenKeyExpired is returned by a stream session when binance reports its listen key expired
+var errListenKeyExpired = errors.New("listen key expired")
+
+// CreateListenKeyFuture create a listen key for USD-M futures user data stream
+func (bc *Client) CreateListenKeyFuture() (string, error) {
+	api := bc.usdmFutureAPI()
+	return bc.createListenKey(api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// KeepListenKeyAliveFuture keep it alive
+func (bc *Client) KeepListenKeyAliveFuture(listenKey string) error {
+	api := bc.usdmFutureAPI()
+	return bc.keepListenKeyAlive(listenKey, api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// CloseListenKeyFuture close the USD-M futures user data stream
+func (bc *Client) CloseListenKeyFuture(listenKey string) error {
+	api := bc.usdmFutureAPI()
+	return bc.closeListenKey(listenKey, api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// CreateListenKeyCoinMFuture create a listen key for COIN-M futures user data stream
+func (bc *Client) CreateListenKeyCoinMFuture() (string, error) {
+	api := bc.coinMFutureAPI()
+	return bc.createListenKey(api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// KeepListenKeyAliveCoinMFuture keep it alive
+func (bc *Client) KeepListenKeyAliveCoinMFuture(listenKey string) error {
+	api := bc.coinMFutureAPI()
+	return bc.keepListenKeyAlive(listenKey, api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// CloseListenKeyCoinMFuture close the COIN-M futures user data stream
+func (bc *Client) CloseListenKeyCoinMFuture(listenKey string) error {
+	api := bc.coinMFutureAPI()
+	return bc.closeListenKey(listenKey, api.baseURL, api.prefix+"/"+listenKeyFutureAPI)
+}
+
+// FutureAccountUpdateReason is the reason of an ACCOUNT_UPDATE event
+type FutureAccountUpdateReason string
+
+const (
+	FutureAccountUpdateDeposit             FutureAccountUpdateReason = "DEPOSIT"
+	FutureAccountUpdateWithdraw            FutureAccountUpdateReason = "WITHDRAW"
+	FutureAccountUpdateOrder               FutureAccountUpdateReason = "ORDER"
+	FutureAccountUpdateFundingFee          FutureAccountUpdateReason = "FUNDING_FEE"
+	FutureAccountUpdateWithdrawReject      FutureAccountUpdateReason = "WITHDRAW_REJECT"
+	FutureAccountUpdateAdjustment          FutureAccountUpdateReason = "ADJUSTMENT"
+	FutureAccountUpdateInsuranceClear      FutureAccountUpdateReason = "INSURANCE_CLEAR"
+	FutureAccountUpdateAdminDeposit        FutureAccountUpdateReason = "ADMIN_DEPOSIT"
+	FutureAccountUpdateAdminWithdraw       FutureAccountUpdateReason = "ADMIN_WITHDRAW"
+	FutureAccountUpdateMarginTransfer      FutureAccountUpdateReason = "MARGIN_TRANSFER"
+	FutureAccountUpdateMarginTypeChange    FutureAccountUpdateReason = "MARGIN_TYPE_CHANGE"
+	FutureAccountUpdateAssetTransfer       FutureAccountUpdateReason = "ASSET_TRANSFER"
+	FutureAccountUpdateOptionsPremiumFee   FutureAccountUpdateReason = "OPTIONS_PREMIUM_FEE"
+	FutureAccountUpdateOptionsSettleProfit FutureAccountUpdateReason = "OPTIONS_SETTLE_PROFIT"
+	FutureAccountUpdateAutoExchange        FutureAccountUpdateReason = "AUTO_EXCHANGE"
+	FutureAccountUpdateCoinSwapDeposit     FutureAccountUpdateReason = "COIN_SWAP_DEPOSIT"
+	FutureAccountUpdateCoinSwapWithdraw    FutureAccountUpdateReason = "COIN_SWAP_WITHDRAW"
+)
+
+// FutureAccountUpdateEvent is pushed when balances or positions change
+type FutureAccountUpdateEvent struct {
+	Event           string `json:"e"`
+	EventTime       int64  `json:"E"`
+	TransactionTime int64  `json:"T"`
+	Update          struct {
+		Reason    FutureAccountUpdateReason `json:"m"`
+		Balances  []FutureStreamBalance     `json:"B"`
+		Positions []FutureStreamPosition    `json:"P"`
+	} `json:"a"`
+}
+
+// FutureStreamBalance ...
+type FutureStreamBalance struct {
+	Asset              string          `json:"a"`
+	WalletBalance      decimal.Decimal `json:"wb"`
+	CrossWalletBalance decimal.Decimal `json:"cw"`
+	BalanceChange      decimal.Decimal `json:"bc"`
+}
+
+// FutureStreamPosition ...
+type FutureStreamPosition struct {
+	Symbol              string          `json:"s"`
+	PositionAmount      decimal.Decimal `json:"pa"`
+	EntryPrice          decimal.Decimal `json:"ep"`
+	BreakEvenPrice      decimal.Decimal `json:"bep"`
+	AccumulatedRealized decimal.Decimal `json:"cr"`
+	UnrealizedPnL       decimal.Decimal `json:"up"`
+	MarginType          string          `json:"mt"`
+	IsolatedWallet      decimal.Decimal `json:"iw"`
+	PositionSide        string          `json:"ps"`
+}
+
+// FutureOrderTradeUpdateEvent is pushed when an order is created, traded or its status changes
+type FutureOrderTradeUpdateEvent struct {
+	Event           string            `json:"e"`
+	EventTime       int64             `json:"E"`
+	TransactionTime int64             `json:"T"`
+	Order           FutureStreamOrder `json:"o"`
+}
+
+// FutureStreamOrder ...
+type FutureStreamOrder struct {
+	Symbol                  string          `json:"s"`
+	ClientOrderID           string          `json:"c"`
+	Side                    string          `json:"S"`
+	OrderType               string          `json:"o"`
+	TimeInForce             string          `json:"f"`
+	OriginalQuantity        decimal.Decimal `json:"q"`
+	OriginalPrice           decimal.Decimal `json:"p"`
+	AveragePrice            decimal.Decimal `json:"ap"`
+	StopPrice               decimal.Decimal `json:"sp"`
+	ExecutionType           string          `json:"x"`
+	OrderStatus             string          `json:"X"`
+	OrderID                 int64           `json:"i"`
+	LastFilledQuantity      decimal.Decimal `json:"l"`
+	FilledAccumulatedQty    decimal.Decimal `json:"z"`
+	LastFilledPrice         decimal.Decimal `json:"L"`
+	CommissionAsset         string          `json:"N"`
+	Commission              decimal.Decimal `json:"n"`
+	TradeTime               int64           `json:"T"`
+	TradeID                 int64           `json:"t"`
+	BidsNotional            decimal.Decimal `json:"b"`
+	AsksNotional            decimal.Decimal `json:"a"`
+	IsMaker                 bool            `json:"m"`
+	IsReduceOnly            bool            `json:"R"`
+	WorkingType             string          `json:"wt"`
+	OriginalOrderType       string          `json:"ot"`
+	PositionSide            string          `json:"ps"`
+	IsClosePosition         bool            `json:"cp"`
+	ActivationPrice         decimal.Decimal `json:"AP"`
+	CallbackRate            decimal.Decimal `json:"cr"`
+	PriceProtect            bool            `json:"pP"`
+	RealizedProfit          decimal.Decimal `json:"rp"`
+	SelfTradePreventionMode string          `json:"V"`
+	PriceMatch              string          `json:"pm"`
+	GoodTillDate            int64           `json:"gtd"`
+}
+
+// FutureMarginCallEvent is pushed when positions are close to liquidation
+type FutureMarginCallEvent struct {
+	Event              string                     `json:"e"`
+	EventTime          int64                      `json:"E"`
+	CrossWalletBalance decimal.Decimal            `json:"cw"`
+	Positions          []FutureMarginCallPosition `json:"p"`
+}
+
+// FutureMarginCallPosition ...
+type FutureMarginCallPosition struct {
+	Symbol                    string          `json:"s"`
+	PositionSide              string          `json:"ps"`
+	PositionAmount            decimal.Decimal `json:"pa"`
+	MarginType                string          `json:"mt"`
+	IsolatedWallet            decimal.Decimal `json:"iw"`
+	MarkPrice                 decimal.Decimal `json:"mp"`
+	UnrealizedPnL             decimal.Decimal `json:"up"`
+	MaintenanceMarginRequired decimal.Decimal `json:"mm"`
+}
+
+// FutureAccountConfigUpdateEvent is pushed when the leverage of a symbol or the multi-assets mode changes
+type FutureAccountConfigUpdateEvent struct {
+	Event           string `json:"e"`
+	EventTime       int64  `json:"E"`
+	TransactionTime int64  `json:"T"`
+	// AccountConfig is set when leverage changes
+	AccountConfig *struct {
+		Symbol   string `json:"s"`
+		Leverage int    `json:"l"`
+	} `json:"ac"`
+	// AccountInfo is set when multi-assets mode changes
+	AccountInfo *struct {
+		MultiAssetsMode bool `json:"j"`
+	} `json:"ai"`
+}
+
+// FutureListenKeyExpiredEvent is pushed when the listen key of the stream expired
+type FutureListenKeyExpiredEvent struct {
+	Event     string        `json:"e"`
+	EventTime FlexibleInt64 `json:"E"`
+	ListenKey string        `json:"listenKey"`
+}
+
+// FlexibleInt64 is an int64 binance sends either as a number or as a string, e.g. the event time of listenKeyExpired
+type FlexibleInt64 int64
+
+// UnmarshalJSON ...
+func (v *FlexibleInt64) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+	if text == "" || text == "null" {
+		*v = 0
+		return nil
+	}
+	parsed, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s, %w", data, err)
+	}
+	*v = FlexibleInt64(parsed)
+	return nil
+}
+
+// ParseFutureUserDataEvent decode a futures user data stream message into one of the Future*Event types,
+// nil is returned for unknown events
+func ParseFutureUserDataEvent(data []byte) (interface{}, error) {
+	// E is only declared so it is not decoded into e, encoding/json matches keys case-insensitively
+	var header struct {
+		Event     string          `json:"e"`
+		EventTime json.RawMessage `json:"E"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse event: %s %w", data, err)
+	}
+	var event interface{}
+	switch header.Event {
+	case "ACCOUNT_UPDATE":
+		event = &FutureAccountUpdateEvent{}
+	case "ORDER_TRADE_UPDATE":
+		event = &FutureOrderTradeUpdateEvent{}
+	case "MARGIN_CALL":
+		event = &FutureMarginCallEvent{}
+	case "ACCOUNT_CONFIG_UPDATE":
+		event = &FutureAccountConfigUpdateEvent{}
+	case "listenKeyExpired":
+		event = &FutureListenKeyExpiredEvent{}
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("failed to parse %s event: %s %w", header.Event, data, err)
+	}
+	return event, nil
+}
+
+// WSConn is a websocket connection used by stream clients, *websocket.Conn of gorilla/websocket satisfies it
+type WSConn interface {
+	ReadMessage() (messageType int, p []byte, err error)
+	Close() error
+}
+
+// WSDialer open a websocket connection to url
+type WSDialer func(ctx context.Context, url string) (WSConn, error)
+
+// FutureUserDataHandler receive decoded futures user data events, nil callbacks are skipped
+type FutureUserDataHandler struct {
+	OnAccountUpdate       func(*FutureAccountUpdateEvent)
+	OnOrderTradeUpdate    func(*FutureOrderTradeUpdateEvent)
+	OnMarginCall          func(*FutureMarginCallEvent)
+	OnAccountConfigUpdate func(*FutureAccountConfigUpdateEvent)
+	OnListenKeyExpired    func(*FutureListenKeyExpiredEvent)
+	// OnError is called with errors the stream recovered from, e.g failed keepalive or a dropped connection
+	OnError func(error)
+}
+
+// FutureUserDataStream maintain a futures user data stream, it keeps the listen key alive and reconnects
+// when the connection drops or the listen key expires
+type FutureUserDataStream struct {
+	createListenKey    func() (string, error)
+	keepListenKeyAlive func(string) error
+	closeListenKey     func(string) error
+	streamURL          string
+	dial               WSDialer
+	handler            FutureUserDataHandler
+
+	// KeepAliveInterval is the interval between listen key keepalive requests, default to 30 minutes, it must be positive
+	KeepAliveInterval time.Duration
+	// ReconnectDelay is the wait time before reconnecting, default to 5 seconds, it must be positive
+	ReconnectDelay time.Duration
+}
+
+// NewFutureUserDataStream create a USD-M futures user data stream, streamURL is usually USDMStreamURL
+func (bc *Client) NewFutureUserDataStream(streamURL string, dial WSDialer, handler FutureUserDataHandler) *FutureUserDataStream {
+	return &FutureUserDataStream{
+		createListenKey:    bc.CreateListenKeyFuture,
+		keepListenKeyAlive: bc.KeepListenKeyAliveFuture,
+		closeListenKey:     bc.CloseListenKeyFuture,
+		streamURL:          streamURL,
+		dial:               dial,
+		handler:            handler,
+		KeepAliveInterval:  defaultFutureKeepAliveInterval,
+		ReconnectDelay:     defaultFutureReconnectDelay,
+	}
+}
+
+// NewCoinMFutureUserDataStream create a COIN-M futures user data stream, streamURL is usually COINMStreamURL
+func (bc *Client) NewCoinMFutureUserDataStream(streamURL string, dial WSDialer, handler FutureUserDataHandler) *FutureUserDataStream {
+	return &FutureUserDataStream{
+		createListenKey:    bc.CreateListenKeyCoinMFuture,
+		keepListenKeyAlive: bc.KeepListenKeyAliveCoinMFuture,
+		closeListenKey:     bc.CloseListenKeyCoinMFuture,
+		streamURL:          streamURL,
+		dial:               dial,
+		handler:            handler,
+		KeepAliveInterval:  defaultFutureKeepAliveInterval,
+		ReconnectDelay:     defaultFutureReconnectDelay,
+	}
+}
+
+// Run consume the stream until ctx is cancelled, it returns the error of ctx or an error if
+// KeepAliveInterval or ReconnectDelay is not positive
+func (s *FutureUserDataStream) Run(ctx context.Context) error {
+	if s.KeepAliveInterval <= 0 {
+		return fmt.Errorf("keepalive interval must be positive, got %s", s.KeepAliveInterval)
+	}
+	if s.ReconnectDelay <= 0 {
+		return fmt.Errorf("reconnect delay must be positive, got %s", s.ReconnectDelay)
+	}
+	var listenKey string
+	for {
+		// a session which failed to create its listen key returns an empty one, the previous key is kept so it is
+		// still closed on exit
+		key, err := s.session(ctx)
+		if key != "" {
+			listenKey = key
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		s.reportError(err)
+		select {
+		case <-ctx.Done():
+		case <-time.After(s.ReconnectDelay):
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if listenKey != "" {
+		s.reportError(s.closeListenKey(listenKey))
+	}
+	return ctx.Err()
+}
+
+// session run one connection of the stream, it returns the listen key in use and the error that ended the session
+func (s *FutureUserDataStream) session(ctx context.Context) (string, error) {
+	listenKey, err := s.createListenKey()
+	if err != nil {
+		return "", fmt.Errorf("failed to create listen key, %w", err)
+	}
+	conn, err := s.dial(ctx, fmt.Sprintf("%s/%s", s.streamURL, listenKey))
+	if err != nil {
+		return listenKey, fmt.Errorf("failed to connect to user data stream, %w", err)
+	}
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		// closing the connection unblocks ReadMessage when the session ends
+		<-sessionCtx.Done()
+		_ = conn.Close()
+	}()
+	go s.keepAlive(sessionCtx, listenKey)
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return listenKey, fmt.Errorf("failed to read user data stream, %w", err)
+		}
+		event, err := ParseFutureUserDataEvent(msg)
+		if err != nil {
+			s.reportError(err)
+			continue
+		}
+		if _, ok := event.(*FutureListenKeyExpiredEvent); ok {
+			s.dispatch(event)
+			return listenKey, errListenKeyExpired
+		}
+		s.dispatch(event)
+	}
+}
+
+func (s *FutureUserDataStream) keepAlive(ctx context.Context, listenKey string) {
+	ticker := time.NewTicker(s.KeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.keepListenKeyAlive(listenKey); err != nil {
+				s.reportError(fmt.Errorf("failed to keep listen key alive, %w", err))
+			}
+		}
+	}
+}
+
+func (s *FutureUserDataStream) dispatch(event interface{}) {
+	h := s.handler
+	switch e := event.(type) {
+	case *FutureAccountUpdateEvent:
+		if h.OnAccountUpdate != nil {
+			h.OnAccountUpdate(e)
+		}
+	case *FutureOrderTradeUpdateEvent:
+		if h.OnOrderTradeUpdate != nil {
+			h.OnOrderTradeUpdate(e)
+		}
+	case *FutureMarginCallEvent:
+		if h.OnMarginCall != nil {
+			h.OnMarginCall(e)
+		}
+	case *FutureAccountConfigUpdateEvent:
+		if h.OnAccountConfigUpdate != nil {
+			h.OnAccountConfigUpdate(e)
+		}
+	case *FutureListenKeyExpiredEvent:
+		if h.OnListenKeyExpired != nil {
+			h.OnListenKeyExpired(e)
+		}
+	}
+}
+
+func (s *FutureUserDataStream) reportError(err error) {
+	if err != nil && s.handler.OnError != nil {
+		s.handler.OnError(err)
+	}
+}
@@ -0,0 +1,79 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseFutureUserDataEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "listen key expired with string event time",
+			data: `{"e":"listenKeyExpired","E":"1736996475556","listenKey":"key"}`,
+			want: &FutureListenKeyExpiredEvent{Event: "listenKeyExpired", EventTime: 1736996475556, ListenKey: "key"},
+		},
+		{
+			name: "listen key expired with number event time",
+			data: `{"e":"listenKeyExpired","E":1736996475556,"listenKey":"key"}`,
+			want: &FutureListenKeyExpiredEvent{Event: "listenKeyExpired", EventTime: 1736996475556, ListenKey: "key"},
+		},
+		{
+			name: "unknown event with string event time",
+			data: `{"e":"SOMETHING_NEW","E":"1736996475556"}`,
+			want: nil,
+		},
+		{
+			name:    "invalid event time",
+			data:    `{"e":"listenKeyExpired","E":"soon","listenKey":"key"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFutureUserDataEvent([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFutureUserDataEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFutureUserDataEvent() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFutureUserDataStreamRunValidation(t *testing.T) {
+	tests := []struct {
+		name              string
+		keepAliveInterval time.Duration
+		reconnectDelay    time.Duration
+	}{
+		{name: "zero keepalive interval", keepAliveInterval: 0, reconnectDelay: time.Second},
+		{name: "negative keepalive interval", keepAliveInterval: -time.Second, reconnectDelay: time.Second},
+		{name: "zero reconnect delay", keepAliveInterval: time.Second, reconnectDelay: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var dialed bool
+			dial := func(ctx context.Context, url string) (WSConn, error) {
+				dialed = true
+				return nil, errors.New("not dialed")
+			}
+			s := (&Client{}).NewFutureUserDataStream(USDMStreamURL, dial, FutureUserDataHandler{})
+			s.KeepAliveInterval, s.ReconnectDelay = tt.keepAliveInterval, tt.reconnectDelay
+			if err := s.Run(context.Background()); err == nil {
+				t.Fatal("Run() error = nil, want an error")
+			}
+			if dialed {
+				t.Error("Run() dialed the stream")
+			}
+		})
+	}
+}
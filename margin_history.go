@@ -0,0 +1,405 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	defaultMarginHistoryPageSize = 100
+	// marginInterestHistoryWindow is the longest time range of an interest history request
+	marginInterestHistoryWindow = 30 * 24 * time.Hour
+)
+
+// MarginBorrowRepayType ...
+type MarginBorrowRepayType string
+
+const (
+	MarginBorrow MarginBorrowRepayType = "BORROW"
+	MarginRepay  MarginBorrowRepayType = "REPAY"
+)
+
+// MarginHistoryQuery is the filters of margin history queries, zero values are not sent.
+// Current is the page number starting from 1 and Size is the page size.
+type MarginHistoryQuery struct {
+	Asset          string
+	IsolatedSymbol string
+	StartTime      int64
+	EndTime        int64
+	Current        int
+	Size           int
+}
+
+// MarginBorrowRepayRecord is a margin loan or repay record
+type MarginBorrowRepayRecord struct {
+	Type           MarginBorrowRepayType `json:"type"`
+	IsolatedSymbol string                `json:"isolatedSymbol"`
+	Asset          string                `json:"asset"`
+	Amount         decimal.Decimal       `json:"amount"`
+	Principal      decimal.Decimal       `json:"principal"`
+	Interest       decimal.Decimal       `json:"interest"`
+	Status         string                `json:"status"`
+	Timestamp      int64                 `json:"timestamp"`
+	TxID           uint64                `json:"txId"`
+}
+
+// MarginInterest is a margin interest record
+type MarginInterest struct {
+	TxID                uint64          `json:"txId"`
+	InterestAccuredTime int64           `json:"interestAccuredTime"`
+	Asset               string          `json:"asset"`
+	RawAsset            string          `json:"rawAsset"`
+	Principal           decimal.Decimal `json:"principal"`
+	Interest            decimal.Decimal `json:"interest"`
+	InterestRate        decimal.Decimal `json:"interestRate"`
+	Type                string          `json:"type"`
+	IsolatedSymbol      string          `json:"isolatedSymbol"`
+}
+
+// MarginForceLiquidation is a margin forced liquidation record
+type MarginForceLiquidation struct {
+	OrderID     int64           `json:"orderId"`
+	Symbol      string          `json:"symbol"`
+	Side        string          `json:"side"`
+	Price       decimal.Decimal `json:"price"`
+	AvgPrice    decimal.Decimal `json:"avgPrice"`
+	Qty         decimal.Decimal `json:"qty"`
+	ExecutedQty decimal.Decimal `json:"executedQty"`
+	TimeInForce string          `json:"timeInForce"`
+	IsIsolated  bool            `json:"isIsolated"`
+	UpdatedTime int64           `json:"updatedTime"`
+}
+
+// MarginInterestRate is a daily margin interest rate record
+type MarginInterestRate struct {
+	Asset             string          `json:"asset"`
+	DailyInterestRate decimal.Decimal `json:"dailyInterestRate"`
+	Timestamp         int64           `json:"timestamp"`
+	VipLevel          int             `json:"vipLevel"`
+}
+
+// CrossMarginCollateralRatio is the collateral discount rate tiers of a group of assets
+type CrossMarginCollateralRatio struct {
+	Collaterals []struct {
+		MinUsdValue  decimal.Decimal `json:"minUsdValue"`
+		MaxUsdValue  decimal.Decimal `json:"maxUsdValue"`
+		DiscountRate decimal.Decimal `json:"discountRate"`
+	} `json:"collaterals"`
+	AssetNames []string `json:"assetNames"`
+}
+
+// GetMarginBorrowRepayRecords return margin loan or repay records, txID is optional
+func (bc *Client) GetMarginBorrowRepayRecords(recordType MarginBorrowRepayType, txID uint64, q MarginHistoryQuery) ([]MarginBorrowRepayRecord, int64, *FwdData, error) {
+	var (
+		result struct {
+			Rows  []MarginBorrowRepayRecord `json:"rows"`
+			Total int64                     `json:"total"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/borrow-repay", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	rr := q.withParams(req.WithHeader(apiKeyHeader, bc.apiKey), "isolatedAsset").
+		WithParam("type", string(recordType))
+	if txID != 0 {
+		rr = rr.WithParam("txId", strconv.FormatUint(txID, 10))
+	}
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.Rows, result.Total, fwd, err
+}
+
+// GetMarginInterestHistory return margin interest records, binance rejects ranges longer than 30 days and default to
+// the last 7 days without q.StartTime
+func (bc *Client) GetMarginInterestHistory(q MarginHistoryQuery) ([]MarginInterest, int64, *FwdData, error) {
+	var (
+		result struct {
+			Rows  []MarginInterest `json:"rows"`
+			Total int64            `json:"total"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/interestHistory", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	rr := q.withParams(req.WithHeader(apiKeyHeader, bc.apiKey), "isolatedSymbol")
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.Rows, result.Total, fwd, err
+}
+
+// GetMarginForceLiquidationRecords return margin forced liquidation records, q.Asset is not used
+func (bc *Client) GetMarginForceLiquidationRecords(q MarginHistoryQuery) ([]MarginForceLiquidation, int64, *FwdData, error) {
+	var (
+		result struct {
+			Rows  []MarginForceLiquidation `json:"rows"`
+			Total int64                    `json:"total"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/forceLiquidationRec", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	q.Asset = ""
+	rr := q.withParams(req.WithHeader(apiKeyHeader, bc.apiKey), "isolatedSymbol")
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.Rows, result.Total, fwd, err
+}
+
+// GetMarginInterestRateHistory return daily interest rates of an asset, vipLevel < 0 means the account's own level
+func (bc *Client) GetMarginInterestRateHistory(asset string, vipLevel int, startTime, endTime int64) ([]MarginInterestRate, *FwdData, error) {
+	var (
+		result []MarginInterestRate
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/interestRateHistory", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("asset", asset)
+	if vipLevel >= 0 {
+		rr = rr.WithParam("vipLevel", strconv.Itoa(vipLevel))
+	}
+	rr = withTimeRangeParams(rr, startTime, endTime, 0)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetCrossMarginCollateralRatio return the collateral discount rates of the cross margin account
+func (bc *Client) GetCrossMarginCollateralRatio() ([]CrossMarginCollateralRatio, *FwdData, error) {
+	var (
+		result []CrossMarginCollateralRatio
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/crossMarginCollateralRatio", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).Request()
+	fwd, err := bc.doRequest(rr, &result)
+	return result, fwd, err
+}
+
+func (q MarginHistoryQuery) withParams(rb *RequestBuilder, isolatedSymbolParam string) *RequestBuilder {
+	rb = withOptionalParam(rb, "asset", q.Asset)
+	rb = withOptionalParam(rb, isolatedSymbolParam, q.IsolatedSymbol)
+	rb = withTimeRangeParams(rb, q.StartTime, q.EndTime, 0)
+	if q.Current > 0 {
+		rb = rb.WithParam("current", strconv.Itoa(q.Current))
+	}
+	if q.Size > 0 {
+		rb = rb.WithParam("size", strconv.Itoa(q.Size))
+	}
+	return rb
+}
+
+// pageCursor walk the pages of a current/size paginated endpoint
+type pageCursor struct {
+	current int
+	size    int
+	fetched int64
+	done    bool
+	err     error
+}
+
+func newPageCursor(current, size, defaultSize int) pageCursor {
+	if current <= 0 {
+		current = 1
+	}
+	if size <= 0 {
+		size = defaultSize
+	}
+	return pageCursor{current: current - 1, size: size}
+}
+
+// next fetch the following page with fetch, which returns the number of rows of the page and the total of all pages
+func (c *pageCursor) next(fetch func(current, size int) (int, int64, error)) bool {
+	if c.done {
+		return false
+	}
+	c.current++
+	rows, total, err := fetch(c.current, c.size)
+	if err != nil {
+		c.err = err
+		c.done = true
+		return false
+	}
+	c.fetched += int64(rows)
+	if rows < c.size || c.fetched >= total {
+		c.done = true
+	}
+	return rows > 0
+}
+
+// MarginBorrowRepayIterator page through margin loan or repay records
+type MarginBorrowRepayIterator struct {
+	bc         *Client
+	recordType MarginBorrowRepayType
+	query      MarginHistoryQuery
+	cursor     pageCursor
+	page       []MarginBorrowRepayRecord
+}
+
+// NewMarginBorrowRepayIterator create an iterator over margin loan or repay records matching q
+func (bc *Client) NewMarginBorrowRepayIterator(recordType MarginBorrowRepayType, q MarginHistoryQuery) *MarginBorrowRepayIterator {
+	return &MarginBorrowRepayIterator{
+		bc:         bc,
+		recordType: recordType,
+		query:      q,
+		cursor:     newPageCursor(q.Current, q.Size, defaultMarginHistoryPageSize),
+	}
+}
+
+// Next fetch the next page, it returns false when there is no more record or an error occurred
+func (it *MarginBorrowRepayIterator) Next() bool {
+	return it.cursor.next(func(current, size int) (int, int64, error) {
+		q := it.query
+		q.Current, q.Size = current, size
+		rows, total, _, err := it.bc.GetMarginBorrowRepayRecords(it.recordType, 0, q)
+		it.page = rows
+		return len(rows), total, err
+	})
+}
+
+// Page return the records fetched by the last call to Next
+func (it *MarginBorrowRepayIterator) Page() []MarginBorrowRepayRecord {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *MarginBorrowRepayIterator) Err() error {
+	return it.cursor.err
+}
+
+// MarginInterestIterator page through margin interest records, the time range is walked by 30 days windows
+type MarginInterestIterator struct {
+	bc     *Client
+	query  MarginHistoryQuery
+	last   int64
+	cursor pageCursor
+	page   []MarginInterest
+}
+
+// NewMarginInterestIterator create an iterator over margin interest records matching q, q.StartTime is required
+// and q.EndTime default to now
+func (bc *Client) NewMarginInterestIterator(q MarginHistoryQuery) *MarginInterestIterator {
+	it := &MarginInterestIterator{
+		bc:     bc,
+		query:  q,
+		last:   q.EndTime,
+		cursor: newPageCursor(q.Current, q.Size, defaultMarginHistoryPageSize),
+	}
+	if it.last == 0 {
+		it.last = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	switch {
+	case q.StartTime == 0:
+		it.cursor.err = errors.New("start time is required to query margin interest history")
+		it.cursor.done = true
+	case q.StartTime > it.last:
+		it.cursor.done = true
+	}
+	it.query.EndTime = it.windowEnd()
+	return it
+}
+
+func (it *MarginInterestIterator) windowEnd() int64 {
+	end := it.query.StartTime + marginInterestHistoryWindow.Milliseconds() - 1
+	if end > it.last {
+		end = it.last
+	}
+	return end
+}
+
+// Next fetch the next page, it returns false when there is no more record or an error occurred
+func (it *MarginInterestIterator) Next() bool {
+	for {
+		if it.cursor.next(func(current, size int) (int, int64, error) {
+			q := it.query
+			q.Current, q.Size = current, size
+			rows, total, _, err := it.bc.GetMarginInterestHistory(q)
+			it.page = rows
+			return len(rows), total, err
+		}) {
+			return true
+		}
+		if it.cursor.err != nil || it.query.EndTime >= it.last {
+			return false
+		}
+		it.query.StartTime = it.query.EndTime + 1
+		it.query.EndTime = it.windowEnd()
+		it.cursor = newPageCursor(1, it.cursor.size, defaultMarginHistoryPageSize)
+	}
+}
+
+// Page return the records fetched by the last call to Next
+func (it *MarginInterestIterator) Page() []MarginInterest {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *MarginInterestIterator) Err() error {
+	return it.cursor.err
+}
+
+// MarginForceLiquidationIterator page through margin forced liquidation records
+type MarginForceLiquidationIterator struct {
+	bc     *Client
+	query  MarginHistoryQuery
+	cursor pageCursor
+	page   []MarginForceLiquidation
+}
+
+// NewMarginForceLiquidationIterator create an iterator over margin forced liquidation records matching q
+func (bc *Client) NewMarginForceLiquidationIterator(q MarginHistoryQuery) *MarginForceLiquidationIterator {
+	return &MarginForceLiquidationIterator{
+		bc:     bc,
+		query:  q,
+		cursor: newPageCursor(q.Current, q.Size, defaultMarginHistoryPageSize),
+	}
+}
+
+// Next fetch the next page, it returns false when there is no more record or an error occurred
+func (it *MarginForceLiquidationIterator) Next() bool {
+	return it.cursor.next(func(current, size int) (int, int64, error) {
+		q := it.query
+		q.Current, q.Size = current, size
+		rows, total, _, err := it.bc.GetMarginForceLiquidationRecords(q)
+		it.page = rows
+		return len(rows), total, err
+	})
+}
+
+// Page return the records fetched by the last call to Next
+func (it *MarginForceLiquidationIterator) Page() []MarginForceLiquidation {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *MarginForceLiquidationIterator) Err() error {
+	return it.cursor.err
+}
+
+// GetMarginInterestByAsset return the total interest paid per asset for records matching q, e.g. over a month for a
+// borrowing-cost report, q.StartTime is required
+func (bc *Client) GetMarginInterestByAsset(q MarginHistoryQuery) (map[string]decimal.Decimal, error) {
+	result := make(map[string]decimal.Decimal)
+	it := bc.NewMarginInterestIterator(q)
+	for it.Next() {
+		for _, r := range it.Page() {
+			result[r.Asset] = result[r.Asset].Add(r.Interest)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,192 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestPageCursor(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      int
+		total     int64
+		pages     []int // rows returned by each fetch
+		wantCalls int
+		wantPages int
+	}{
+		{name: "last page is short", size: 2, total: 5, pages: []int{2, 2, 1}, wantCalls: 3, wantPages: 3},
+		{name: "total is a multiple of size", size: 2, total: 4, pages: []int{2, 2}, wantCalls: 2, wantPages: 2},
+		{name: "empty", size: 2, total: 0, pages: []int{0}, wantCalls: 1, wantPages: 0},
+		{name: "rows removed while paging", size: 2, total: 6, pages: []int{2, 2, 0}, wantCalls: 3, wantPages: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newPageCursor(0, tt.size, defaultMarginHistoryPageSize)
+			calls, pages := 0, 0
+			for c.next(func(current, size int) (int, int64, error) {
+				if current != calls+1 || size != tt.size {
+					t.Errorf("fetch(%d, %d), want fetch(%d, %d)", current, size, calls+1, tt.size)
+				}
+				rows := tt.pages[calls]
+				calls++
+				return rows, tt.total, nil
+			}) {
+				pages++
+			}
+			if calls != tt.wantCalls || pages != tt.wantPages {
+				t.Errorf("%d calls and %d pages, want %d and %d", calls, pages, tt.wantCalls, tt.wantPages)
+			}
+			if c.err != nil {
+				t.Error(c.err)
+			}
+		})
+	}
+}
+
+// marginHistoryCall is the range and page of a margin history request
+type marginHistoryCall struct {
+	start, end    int64
+	current, size int
+}
+
+// newMarginHistoryServer serve records at times filtered by startTime and endTime and paged by current and size
+func newMarginHistoryServer(t *testing.T, path string, times []int64, calls *[]marginHistoryCall) *Client {
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		start, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+		current, _ := strconv.Atoi(q.Get("current"))
+		size, _ := strconv.Atoi(q.Get("size"))
+		*calls = append(*calls, marginHistoryCall{start: start, end: end, current: current, size: size})
+		var matched []int64
+		for _, tm := range times {
+			if (start == 0 || tm >= start) && (end == 0 || tm <= end) {
+				matched = append(matched, tm)
+			}
+		}
+		total := len(matched)
+		from := (current - 1) * size
+		if from > len(matched) {
+			from = len(matched)
+		}
+		matched = matched[from:]
+		if len(matched) > size {
+			matched = matched[:size]
+		}
+		rows := []map[string]interface{}{}
+		for _, tm := range matched {
+			rows = append(rows, map[string]interface{}{
+				"txId": tm, "timestamp": tm, "interestAccuredTime": tm, "asset": "BTC", "interest": "0.5",
+			})
+		}
+		writeJSON(t, w, map[string]interface{}{"rows": rows, "total": total})
+	})
+}
+
+func TestMarginBorrowRepayIterator(t *testing.T) {
+	var calls []marginHistoryCall
+	bc := newMarginHistoryServer(t, "/sapi/v1/margin/borrow-repay", []int64{1, 2, 3, 4}, &calls)
+	it := bc.NewMarginBorrowRepayIterator(MarginBorrow, MarginHistoryQuery{Size: 2})
+	var txIDs []uint64
+	for it.Next() {
+		for _, r := range it.Page() {
+			txIDs = append(txIDs, r.TxID)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []uint64{1, 2, 3, 4}; !reflect.DeepEqual(txIDs, want) {
+		t.Errorf("txIDs = %v, want %v", txIDs, want)
+	}
+	// the total is a multiple of the page size, there is no request for an empty third page
+	if want := []marginHistoryCall{{current: 1, size: 2}, {current: 2, size: 2}}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestMarginInterestIterator(t *testing.T) {
+	window := marginInterestHistoryWindow.Milliseconds()
+	end := int64(1700000000000)
+	tests := []struct {
+		name      string
+		query     MarginHistoryQuery
+		times     []int64
+		wantCalls []marginHistoryCall
+		wantTimes []int64
+		wantErr   bool
+	}{
+		{
+			name:  "windows and pages",
+			query: MarginHistoryQuery{StartTime: end - window - 9, EndTime: end, Size: 2},
+			times: []int64{end - window - 9, end - window - 8, end - 10, end - 9, end},
+			wantCalls: []marginHistoryCall{
+				{start: end - window - 9, end: end - 10, current: 1, size: 2},
+				{start: end - window - 9, end: end - 10, current: 2, size: 2},
+				{start: end - 9, end: end, current: 1, size: 2},
+			},
+			wantTimes: []int64{end - window - 9, end - window - 8, end - 10, end - 9, end},
+		},
+		{
+			name:      "single window",
+			query:     MarginHistoryQuery{StartTime: end - window + 1, EndTime: end},
+			times:     []int64{end - window, end - window + 1},
+			wantCalls: []marginHistoryCall{{start: end - window + 1, end: end, current: 1, size: defaultMarginHistoryPageSize}},
+			wantTimes: []int64{end - window + 1},
+		},
+		{
+			name:    "start time is required",
+			query:   MarginHistoryQuery{EndTime: end},
+			wantErr: true,
+		},
+		{
+			name:  "empty range",
+			query: MarginHistoryQuery{StartTime: end, EndTime: end - 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []marginHistoryCall
+			bc := newMarginHistoryServer(t, "/sapi/v1/margin/interestHistory", tt.times, &calls)
+			it := bc.NewMarginInterestIterator(tt.query)
+			var times []int64
+			for it.Next() {
+				for _, r := range it.Page() {
+					times = append(times, r.InterestAccuredTime)
+				}
+			}
+			if (it.Err() != nil) != tt.wantErr {
+				t.Fatalf("Err() = %v, wantErr %v", it.Err(), tt.wantErr)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			if !reflect.DeepEqual(times, tt.wantTimes) {
+				t.Errorf("times = %v, want %v", times, tt.wantTimes)
+			}
+		})
+	}
+}
+
+func TestGetMarginInterestByAsset(t *testing.T) {
+	end := int64(1700000000000)
+	var calls []marginHistoryCall
+	bc := newMarginHistoryServer(t, "/sapi/v1/margin/interestHistory", []int64{end - 2, end - 1, end}, &calls)
+	got, err := bc.GetMarginInterestByAsset(MarginHistoryQuery{StartTime: end - 10, EndTime: end})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !got["BTC"].Equal(decimal.RequireFromString("1.5")) {
+		t.Errorf("GetMarginInterestByAsset() = %v, want BTC: 1.5", got)
+	}
+	if _, err := bc.GetMarginInterestByAsset(MarginHistoryQuery{EndTime: end}); err == nil {
+		t.Error("GetMarginInterestByAsset() without start time error = nil, want an error")
+	}
+}
@@ -0,0 +1,443 @@
+package binance
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MarginAssetBalance is the balance of an asset in a margin account
+type MarginAssetBalance struct {
+	Asset    string
+	Free     decimal.Decimal
+	Borrowed decimal.Decimal
+	Interest decimal.Decimal
+}
+
+// Debt return the borrowed amount plus the interest
+func (b MarginAssetBalance) Debt() decimal.Decimal {
+	return b.Borrowed.Add(b.Interest)
+}
+
+// MarginAccountState is a snapshot of a margin account, Symbol is empty for the cross margin account
+type MarginAccountState struct {
+	Symbol      string
+	MarginLevel decimal.Decimal
+	Assets      []MarginAssetBalance
+}
+
+// IsIsolated return true if the state is of an isolated margin account
+func (s MarginAccountState) IsIsolated() bool {
+	return s.Symbol != ""
+}
+
+func (s MarginAccountState) name() string {
+	if s.IsIsolated() {
+		return s.Symbol
+	}
+	return "cross"
+}
+
+// MarginLevelThreshold is breached when the margin level falls to or below Level, the actions are run each time the
+// threshold gets breached and the failed ones are retried at every poll while it stays breached
+type MarginLevelThreshold struct {
+	Name    string
+	Level   decimal.Decimal
+	Actions []MarginRiskAction
+}
+
+// MarginRiskEvent is emitted when a margin level crosses a threshold, Breached is false when the level recovered
+// above it. Retry is true when the threshold was already breached and the event reports the retry of failed actions.
+type MarginRiskEvent struct {
+	Time         time.Time
+	State        MarginAccountState
+	Threshold    MarginLevelThreshold
+	Breached     bool
+	Retry        bool
+	ActionErrors []error
+}
+
+// MarginRiskAction is run when a threshold is breached
+type MarginRiskAction interface {
+	Run(bc *Client, state MarginAccountState) error
+}
+
+// MarginRepayAction repay the debt of Assets (all assets if empty) from the free balance of the margin account
+type MarginRepayAction struct {
+	Assets []string
+}
+
+// Run ...
+func (a MarginRepayAction) Run(bc *Client, state MarginAccountState) error {
+	var failed []string
+	for _, b := range state.Assets {
+		if len(a.Assets) > 0 && !containsString(a.Assets, b.Asset) {
+			continue
+		}
+		amount := decimal.Min(b.Free, b.Debt())
+		if !amount.IsPositive() {
+			continue
+		}
+		if _, _, err := bc.Repay(b.Asset, state.Symbol, amount.String(), state.IsIsolated()); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", b.Asset, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to repay %s, %s", state.name(), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// MarginTransferInAction transfer Amount of Asset from the spot account into the margin account as collateral
+type MarginTransferInAction struct {
+	Asset  string
+	Amount decimal.Decimal
+}
+
+// Run ...
+func (a MarginTransferInAction) Run(bc *Client, state MarginAccountState) error {
+	var err error
+	if state.IsIsolated() {
+		_, _, err = bc.TransferIsolatedMargin(a.Asset, state.Symbol, a.Amount.String(), SpotWallet, IsolatedMarginWallet)
+	} else {
+		_, _, err = bc.TransferCrossMargin(a.Asset, a.Amount.String(), true)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to transfer %s %s into %s, %w", a.Amount, a.Asset, state.name(), err)
+	}
+	return nil
+}
+
+// MarginCancelOrdersAction cancel the open orders of the margin account, Symbols is required for the cross margin
+// account and ignored for isolated ones
+type MarginCancelOrdersAction struct {
+	Symbols []string
+}
+
+// Run ...
+func (a MarginCancelOrdersAction) Run(bc *Client, state MarginAccountState) error {
+	symbols := a.Symbols
+	if state.IsIsolated() {
+		symbols = []string{state.Symbol}
+	}
+	var failed []string
+	for _, symbol := range symbols {
+		if _, _, err := bc.CancelAllMarginOrders(symbol, state.IsIsolated()); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", symbol, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to cancel orders of %s, %s", state.name(), strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// MarginRiskMonitor poll the margin level of the cross margin account and the watched isolated margin accounts and
+// emit an event when a threshold is crossed
+type MarginRiskMonitor struct {
+	bc       *Client
+	interval time.Duration
+
+	// OnEvent is called for every threshold crossing and every retry of failed actions, after the actions were run
+	OnEvent func(MarginRiskEvent)
+	// OnError is called when an account could not be polled
+	OnError func(error)
+
+	mu       sync.Mutex
+	cross    []MarginLevelThreshold
+	isolated map[string][]MarginLevelThreshold
+	levels   map[string]*marginLevelState
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// marginLevelState is the state of a threshold of an account, succeeded tells which actions succeeded since the
+// threshold got breached
+type marginLevelState struct {
+	breached  bool
+	succeeded []bool
+}
+
+// NewMarginRiskMonitor create a monitor which polls margin levels each interval
+func (bc *Client) NewMarginRiskMonitor(interval time.Duration) (*MarginRiskMonitor, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s", interval)
+	}
+	return &MarginRiskMonitor{
+		bc:       bc,
+		interval: interval,
+		isolated: make(map[string][]MarginLevelThreshold),
+		levels:   make(map[string]*marginLevelState),
+	}, nil
+}
+
+// WatchCross set the thresholds of the cross margin account, no threshold stops watching it
+func (m *MarginRiskMonitor) WatchCross(thresholds ...MarginLevelThreshold) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cross = thresholds
+	m.resetLevels("")
+}
+
+// WatchIsolated set the thresholds of the isolated margin account of symbol, no threshold stops watching it
+func (m *MarginRiskMonitor) WatchIsolated(symbol string, thresholds ...MarginLevelThreshold) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(thresholds) == 0 {
+		delete(m.isolated, symbol)
+	} else {
+		m.isolated[symbol] = thresholds
+	}
+	m.resetLevels(symbol)
+}
+
+// Start run the monitor in background until Stop is called
+func (m *MarginRiskMonitor) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stop != nil {
+		return
+	}
+	m.stop = make(chan struct{})
+	m.done = make(chan struct{})
+	go m.run(m.stop, m.done)
+}
+
+// Stop end the monitor
+func (m *MarginRiskMonitor) Stop() {
+	m.mu.Lock()
+	stop, done := m.stop, m.done
+	m.stop, m.done = nil, nil
+	m.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-done
+	}
+}
+
+// Check poll every watched account once and return the emitted events
+func (m *MarginRiskMonitor) Check() ([]MarginRiskEvent, error) {
+	m.mu.Lock()
+	cross := m.cross
+	isolated := make(map[string][]MarginLevelThreshold, len(m.isolated))
+	symbols := make([]string, 0, len(m.isolated))
+	for symbol, thresholds := range m.isolated {
+		isolated[symbol] = thresholds
+		symbols = append(symbols, symbol)
+	}
+	m.mu.Unlock()
+	sort.Strings(symbols)
+
+	var (
+		events []MarginRiskEvent
+		failed []string
+	)
+	if len(cross) > 0 {
+		state, err := m.crossState()
+		if err != nil {
+			failed = append(failed, err.Error())
+		} else {
+			events = append(events, m.evaluate(state, cross)...)
+		}
+	}
+	if len(symbols) > 0 {
+		states, err := m.isolatedStates(symbols)
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+		for _, state := range states {
+			events = append(events, m.evaluate(state, isolated[state.Symbol])...)
+		}
+	}
+	if len(failed) > 0 {
+		return events, fmt.Errorf("failed to poll margin level, %s", strings.Join(failed, "; "))
+	}
+	return events, nil
+}
+
+func (m *MarginRiskMonitor) run(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		m.poll()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *MarginRiskMonitor) poll() {
+	events, err := m.Check()
+	if err != nil && m.OnError != nil {
+		m.OnError(err)
+	}
+	if m.OnEvent != nil {
+		for _, ev := range events {
+			m.OnEvent(ev)
+		}
+	}
+}
+
+// evaluate compare the state against the thresholds, it runs the actions of the newly breached ones and retries the
+// failed actions of the ones still breached
+func (m *MarginRiskMonitor) evaluate(state MarginAccountState, thresholds []MarginLevelThreshold) []MarginRiskEvent {
+	var events []MarginRiskEvent
+	for i, threshold := range thresholds {
+		key := levelKey(state.Symbol, i)
+		breached := state.MarginLevel.LessThanOrEqual(threshold.Level)
+		m.mu.Lock()
+		level, ok := m.levels[key]
+		if !ok {
+			level = &marginLevelState{}
+			m.levels[key] = level
+		}
+		changed := level.breached != breached
+		level.breached = breached
+		if changed {
+			level.succeeded = make([]bool, len(threshold.Actions))
+		}
+		// succeeded is replaced when the threshold is crossed again, results of this run are then ignored
+		succeeded := level.succeeded
+		var pending []int
+		if breached {
+			for j := range threshold.Actions {
+				if !succeeded[j] {
+					pending = append(pending, j)
+				}
+			}
+		}
+		m.mu.Unlock()
+		if !changed && len(pending) == 0 {
+			continue
+		}
+		ev := MarginRiskEvent{
+			Time:      time.Now(),
+			State:     state,
+			Threshold: threshold,
+			Breached:  breached,
+			Retry:     !changed,
+		}
+		for _, j := range pending {
+			if err := threshold.Actions[j].Run(m.bc, state); err != nil {
+				ev.ActionErrors = append(ev.ActionErrors, err)
+				continue
+			}
+			m.mu.Lock()
+			succeeded[j] = true
+			m.mu.Unlock()
+		}
+		events = append(events, ev)
+	}
+	return events
+}
+
+func (m *MarginRiskMonitor) crossState() (MarginAccountState, error) {
+	details, _, err := m.bc.GetCrossMarginAccountDetails()
+	if err != nil {
+		return MarginAccountState{}, fmt.Errorf("cross: %w", err)
+	}
+	state := MarginAccountState{}
+	if state.MarginLevel, err = decimal.NewFromString(details.MarginLevel); err != nil {
+		return MarginAccountState{}, fmt.Errorf("cross: invalid margin level %q, %w", details.MarginLevel, err)
+	}
+	for _, a := range details.UserAssets {
+		b, err := newMarginAssetBalance(a.Asset, a.Free, a.Borrowed, a.Interest)
+		if err != nil {
+			return MarginAccountState{}, fmt.Errorf("cross: %w", err)
+		}
+		state.Assets = append(state.Assets, b)
+	}
+	return state, nil
+}
+
+func (m *MarginRiskMonitor) isolatedStates(symbols []string) ([]MarginAccountState, error) {
+	details, err := m.bc.GetIsolatedMarginAccountDetailsAll(symbols)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		states []MarginAccountState
+		failed []string
+		found  = make(map[string]bool, len(details.Assets))
+	)
+	for _, info := range details.Assets {
+		found[info.Symbol] = true
+		state := MarginAccountState{Symbol: info.Symbol}
+		level, err := decimal.NewFromString(info.MarginLevel)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: invalid margin level %q", info.Symbol, info.MarginLevel))
+			continue
+		}
+		state.MarginLevel = level
+		for _, a := range []IsolatedMarginAsset{info.BaseAsset, info.QuoteAsset} {
+			b, err := newMarginAssetBalance(a.Asset, a.Free, a.Borrowed, a.Interest)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%s: %v", info.Symbol, err))
+				continue
+			}
+			state.Assets = append(state.Assets, b)
+		}
+		states = append(states, state)
+	}
+	for _, symbol := range symbols {
+		if !found[symbol] {
+			failed = append(failed, fmt.Sprintf("%s: isolated margin account not found", symbol))
+		}
+	}
+	if len(failed) > 0 {
+		return states, fmt.Errorf("%s", strings.Join(failed, "; "))
+	}
+	return states, nil
+}
+
+// resetLevels forget the threshold states of an account, must be called with mu held
+func (m *MarginRiskMonitor) resetLevels(symbol string) {
+	prefix := symbol + "/"
+	for key := range m.levels {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.levels, key)
+		}
+	}
+}
+
+func levelKey(symbol string, threshold int) string {
+	return fmt.Sprintf("%s/%d", symbol, threshold)
+}
+
+func newMarginAssetBalance(asset, free, borrowed, interest string) (MarginAssetBalance, error) {
+	b := MarginAssetBalance{Asset: asset}
+	for _, v := range []struct {
+		field *decimal.Decimal
+		value string
+	}{
+		{&b.Free, free},
+		{&b.Borrowed, borrowed},
+		{&b.Interest, interest},
+	} {
+		if v.value == "" {
+			continue
+		}
+		d, err := decimal.NewFromString(v.value)
+		if err != nil {
+			return MarginAssetBalance{}, fmt.Errorf("invalid %s balance %q, %w", asset, v.value, err)
+		}
+		*v.field = d
+	}
+	return b, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewMarginRiskMonitor(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "valid", interval: time.Second},
+		{name: "zero", interval: 0, wantErr: true},
+		{name: "negative", interval: -time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&Client{}).NewMarginRiskMonitor(tt.interval)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMarginRiskMonitor(%s) error = %v, wantErr %v", tt.interval, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// summarizeMarginRiskEvents describe events as "breached", "retry" or "recovered", suffixed by "!" when an action
+// failed
+func summarizeMarginRiskEvents(events []MarginRiskEvent) []string {
+	var result []string
+	for _, ev := range events {
+		s := "recovered"
+		if ev.Breached {
+			s = "breached"
+			if ev.Retry {
+				s = "retry"
+			}
+		}
+		if len(ev.ActionErrors) > 0 {
+			s += "!"
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func TestMarginRiskMonitorBreachAndRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		levels     []string
+		repayFails []bool
+		want       [][]string
+		wantRepays int
+	}{
+		{
+			name:       "breach then recover",
+			levels:     []string{"2", "1.1", "1.1", "2"},
+			repayFails: []bool{false},
+			want:       [][]string{nil, {"breached"}, nil, {"recovered"}},
+			wantRepays: 1,
+		},
+		{
+			name:       "retry until the action succeeds",
+			levels:     []string{"1.1", "1.1", "1.1", "1.1"},
+			repayFails: []bool{true, true, false},
+			want:       [][]string{{"breached!"}, {"retry!"}, {"retry"}, nil},
+			wantRepays: 3,
+		},
+		{
+			name:       "no retry once recovered",
+			levels:     []string{"1.1", "2", "2", "1.1"},
+			repayFails: []bool{true, false},
+			want:       [][]string{{"breached!"}, {"recovered"}, nil, {"breached"}},
+			wantRepays: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var poll, repays int
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/sapi/v1/margin/account":
+					writeJSON(t, w, map[string]interface{}{
+						"marginLevel": tt.levels[poll],
+						"userAssets": []map[string]string{
+							{"asset": "USDT", "free": "100", "borrowed": "10", "interest": "0.1"},
+						},
+					})
+				case "/sapi/v1/margin/repay":
+					if got := r.URL.Query().Get("amount"); got != "10.1" {
+						t.Errorf("repay amount = %s, want 10.1", got)
+					}
+					fail := tt.repayFails[repays]
+					repays++
+					if fail {
+						w.WriteHeader(http.StatusBadRequest)
+						_, _ = w.Write([]byte(`{"code":-3041,"msg":"Balance is not enough"}`))
+						return
+					}
+					writeJSON(t, w, map[string]int64{"tranId": 1})
+				default:
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+			})
+			m, err := bc.NewMarginRiskMonitor(time.Second)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.WatchCross(MarginLevelThreshold{
+				Name:    "repay",
+				Level:   decimal.RequireFromString("1.3"),
+				Actions: []MarginRiskAction{MarginRepayAction{}},
+			})
+			for poll = range tt.levels {
+				events, err := m.Check()
+				if err != nil {
+					t.Fatalf("poll %d: Check() error = %v", poll, err)
+				}
+				if got := summarizeMarginRiskEvents(events); !reflect.DeepEqual(got, tt.want[poll]) {
+					t.Errorf("poll %d: events = %v, want %v", poll, got, tt.want[poll])
+				}
+			}
+			if repays != tt.wantRepays {
+				t.Errorf("%d repays, want %d", repays, tt.wantRepays)
+			}
+		})
+	}
+}
+
+func TestMarginRiskMonitorMissingIsolatedSymbol(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sapi/v1/margin/isolated/account" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		writeJSON(t, w, map[string]interface{}{
+			"assets": []map[string]interface{}{
+				{"symbol": "BTCUSDT", "marginLevel": "1.1"},
+			},
+		})
+	})
+	m, err := bc.NewMarginRiskMonitor(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	threshold := MarginLevelThreshold{Name: "warn", Level: decimal.RequireFromString("1.3")}
+	m.WatchIsolated("BTCUSDT", threshold)
+	m.WatchIsolated("ETHUSDT", threshold)
+	events, err := m.Check()
+	if err == nil || !strings.Contains(err.Error(), "ETHUSDT") {
+		t.Errorf("Check() error = %v, want an error about ETHUSDT", err)
+	}
+	if len(events) != 1 || events[0].State.Symbol != "BTCUSDT" || !events[0].Breached {
+		t.Errorf("Check() events = %+v, want BTCUSDT breached", events)
+	}
+}
@@ -0,0 +1,313 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// MarginSideEffectType control the automatic borrow and repay of a margin order
+type MarginSideEffectType string
+
+const (
+	MarginNoSideEffect    MarginSideEffectType = "NO_SIDE_EFFECT"
+	MarginBuy             MarginSideEffectType = "MARGIN_BUY"
+	MarginAutoRepay       MarginSideEffectType = "AUTO_REPAY"
+	MarginAutoBorrowRepay MarginSideEffectType = "AUTO_BORROW_REPAY"
+)
+
+// MarginOrderRequest is the parameters to place a margin order, zero values are not sent
+type MarginOrderRequest struct {
+	Symbol                  string
+	IsIsolated              bool
+	Side                    string
+	Type                    string
+	TimeInForce             string
+	Quantity                decimal.Decimal
+	QuoteOrderQty           decimal.Decimal
+	Price                   decimal.Decimal
+	StopPrice               decimal.Decimal
+	IcebergQty              decimal.Decimal
+	NewClientOrderID        string
+	NewOrderRespType        string
+	SideEffectType          MarginSideEffectType
+	SelfTradePreventionMode string
+}
+
+// MarginOCORequest is the parameters to place a margin OCO order, zero values are not sent
+type MarginOCORequest struct {
+	Symbol               string
+	IsIsolated           bool
+	Side                 string
+	Quantity             decimal.Decimal
+	Price                decimal.Decimal
+	StopPrice            decimal.Decimal
+	StopLimitPrice       decimal.Decimal
+	StopLimitTimeInForce string
+	LimitIcebergQty      decimal.Decimal
+	StopIcebergQty       decimal.Decimal
+	ListClientOrderID    string
+	LimitClientOrderID   string
+	StopClientOrderID    string
+	NewOrderRespType     string
+	SideEffectType       MarginSideEffectType
+}
+
+// MarginOrder is a margin order, fields that are not part of a response are left empty
+type MarginOrder struct {
+	Symbol                  string          `json:"symbol"`
+	OrderID                 int64           `json:"orderId"`
+	OrderListID             int64           `json:"orderListId"`
+	ClientOrderID           string          `json:"clientOrderId"`
+	OrigClientOrderID       string          `json:"origClientOrderId"`
+	TransactTime            int64           `json:"transactTime"`
+	Price                   decimal.Decimal `json:"price"`
+	OrigQty                 decimal.Decimal `json:"origQty"`
+	ExecutedQty             decimal.Decimal `json:"executedQty"`
+	CummulativeQuoteQty     decimal.Decimal `json:"cummulativeQuoteQty"`
+	Status                  string          `json:"status"`
+	TimeInForce             string          `json:"timeInForce"`
+	Type                    string          `json:"type"`
+	Side                    string          `json:"side"`
+	StopPrice               decimal.Decimal `json:"stopPrice"`
+	IcebergQty              decimal.Decimal `json:"icebergQty"`
+	Time                    int64           `json:"time"`
+	UpdateTime              int64           `json:"updateTime"`
+	IsWorking               bool            `json:"isWorking"`
+	IsIsolated              bool            `json:"isIsolated"`
+	SelfTradePreventionMode string          `json:"selfTradePreventionMode"`
+	MarginBuyBorrowAmount   decimal.Decimal `json:"marginBuyBorrowAmount"`
+	MarginBuyBorrowAsset    string          `json:"marginBuyBorrowAsset"`
+	Fills                   []struct {
+		Price           decimal.Decimal `json:"price"`
+		Qty             decimal.Decimal `json:"qty"`
+		Commission      decimal.Decimal `json:"commission"`
+		CommissionAsset string          `json:"commissionAsset"`
+		TradeID         int64           `json:"tradeId"`
+	} `json:"fills"`
+}
+
+// MarginOCOOrder is a margin order list
+type MarginOCOOrder struct {
+	OrderListID       int64  `json:"orderListId"`
+	ContingencyType   string `json:"contingencyType"`
+	ListStatusType    string `json:"listStatusType"`
+	ListOrderStatus   string `json:"listOrderStatus"`
+	ListClientOrderID string `json:"listClientOrderId"`
+	TransactionTime   int64  `json:"transactionTime"`
+	Symbol            string `json:"symbol"`
+	IsIsolated        bool   `json:"isIsolated"`
+	Orders            []struct {
+		Symbol        string `json:"symbol"`
+		OrderID       int64  `json:"orderId"`
+		ClientOrderID string `json:"clientOrderId"`
+	} `json:"orders"`
+	OrderReports []MarginOrder `json:"orderReports"`
+}
+
+// MarginTrade is a margin account trade
+type MarginTrade struct {
+	ID              int64           `json:"id"`
+	Symbol          string          `json:"symbol"`
+	OrderID         int64           `json:"orderId"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	Time            int64           `json:"time"`
+	IsBuyer         bool            `json:"isBuyer"`
+	IsMaker         bool            `json:"isMaker"`
+	IsBestMatch     bool            `json:"isBestMatch"`
+	IsIsolated      bool            `json:"isIsolated"`
+}
+
+// CreateMarginOrder place a new margin order
+func (bc *Client) CreateMarginOrder(r MarginOrderRequest) (MarginOrder, *FwdData, error) {
+	var (
+		result MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/order", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), r.IsIsolated).
+		WithParam("symbol", r.Symbol).
+		WithParam("side", r.Side).
+		WithParam("type", r.Type)
+	rr = withOptionalParam(rr, "timeInForce", r.TimeInForce)
+	rr = withDecimalParam(rr, "quantity", r.Quantity)
+	rr = withDecimalParam(rr, "quoteOrderQty", r.QuoteOrderQty)
+	rr = withDecimalParam(rr, "price", r.Price)
+	rr = withDecimalParam(rr, "stopPrice", r.StopPrice)
+	rr = withDecimalParam(rr, "icebergQty", r.IcebergQty)
+	rr = withOptionalParam(rr, "newClientOrderId", r.NewClientOrderID)
+	rr = withOptionalParam(rr, "newOrderRespType", r.NewOrderRespType)
+	rr = withOptionalParam(rr, "sideEffectType", string(r.SideEffectType))
+	rr = withOptionalParam(rr, "selfTradePreventionMode", r.SelfTradePreventionMode)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// CancelMarginOrder cancel a margin order by orderID or origClientOrderID
+func (bc *Client) CancelMarginOrder(symbol string, isIsolated bool, orderID int64, origClientOrderID string) (MarginOrder, *FwdData, error) {
+	var (
+		result MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/order", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	rr = withOrderID(rr, orderID, origClientOrderID)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// CancelAllMarginOrders cancel all open margin orders of a symbol, OCO orders are returned with their order list fields only
+func (bc *Client) CancelAllMarginOrders(symbol string, isIsolated bool) ([]MarginOrder, *FwdData, error) {
+	var (
+		result []MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/openOrders", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// GetMarginOrder query a margin order by orderID or origClientOrderID
+func (bc *Client) GetMarginOrder(symbol string, isIsolated bool, orderID int64, origClientOrderID string) (MarginOrder, *FwdData, error) {
+	var (
+		result MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/order", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	rr = withOrderID(rr, orderID, origClientOrderID)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetMarginOpenOrders return open margin orders, if symbol is empty, cross margin open orders of all symbols will return
+func (bc *Client) GetMarginOpenOrders(symbol string, isIsolated bool) ([]MarginOrder, *FwdData, error) {
+	var (
+		result []MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/openOrders", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated)
+	rr = withOptionalParam(rr, "symbol", symbol)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetMarginAllOrders return all margin orders of a symbol, the zero value of filters are not sent
+func (bc *Client) GetMarginAllOrders(symbol string, isIsolated bool, orderID, startTime, endTime int64, limit int) ([]MarginOrder, *FwdData, error) {
+	var (
+		result []MarginOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/allOrders", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	rr = withOrderID(rr, orderID, "")
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// CreateMarginOCO place a new margin OCO order
+func (bc *Client) CreateMarginOCO(r MarginOCORequest) (MarginOCOOrder, *FwdData, error) {
+	var (
+		result MarginOCOOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/order/oco", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), r.IsIsolated).
+		WithParam("symbol", r.Symbol).
+		WithParam("side", r.Side).
+		WithParam("quantity", r.Quantity.String()).
+		WithParam("price", r.Price.String()).
+		WithParam("stopPrice", r.StopPrice.String())
+	rr = withDecimalParam(rr, "stopLimitPrice", r.StopLimitPrice)
+	rr = withOptionalParam(rr, "stopLimitTimeInForce", r.StopLimitTimeInForce)
+	rr = withDecimalParam(rr, "limitIcebergQty", r.LimitIcebergQty)
+	rr = withDecimalParam(rr, "stopIcebergQty", r.StopIcebergQty)
+	rr = withOptionalParam(rr, "listClientOrderId", r.ListClientOrderID)
+	rr = withOptionalParam(rr, "limitClientOrderId", r.LimitClientOrderID)
+	rr = withOptionalParam(rr, "stopClientOrderId", r.StopClientOrderID)
+	rr = withOptionalParam(rr, "newOrderRespType", r.NewOrderRespType)
+	rr = withOptionalParam(rr, "sideEffectType", string(r.SideEffectType))
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// CancelMarginOCO cancel a margin OCO order by orderListID or listClientOrderID
+func (bc *Client) CancelMarginOCO(symbol string, isIsolated bool, orderListID int64, listClientOrderID string) (MarginOCOOrder, *FwdData, error) {
+	var (
+		result MarginOCOOrder
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/orderList", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodDelete, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	if orderListID != 0 {
+		rr = rr.WithParam("orderListId", strconv.FormatInt(orderListID, 10))
+	}
+	rr = withOptionalParam(rr, "listClientOrderId", listClientOrderID)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	return result, fwd, err
+}
+
+// GetMarginTrades return margin account trades of a symbol, the zero value of filters are not sent
+func (bc *Client) GetMarginTrades(symbol string, isIsolated bool, orderID, startTime, endTime, fromID int64, limit int) ([]MarginTrade, *FwdData, error) {
+	var (
+		result []MarginTrade
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/margin/myTrades", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	rr := withMarginIsolated(req.WithHeader(apiKeyHeader, bc.apiKey), isIsolated).
+		WithParam("symbol", symbol)
+	rr = withOrderID(rr, orderID, "")
+	if fromID != 0 {
+		rr = rr.WithParam("fromId", strconv.FormatInt(fromID, 10))
+	}
+	rr = withTimeRangeParams(rr, startTime, endTime, limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+func withMarginIsolated(rb *RequestBuilder, isIsolated bool) *RequestBuilder {
+	if isIsolated {
+		rb = rb.WithParam("isIsolated", "TRUE")
+	}
+	return rb
+}
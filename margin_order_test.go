@@ -0,0 +1,168 @@
+package binance
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// signedParams return the query params of r without the signature, timestamp and recvWindow
+func signedParams(r *http.Request) map[string]string {
+	params := make(map[string]string)
+	for key := range r.URL.Query() {
+		switch key {
+		case "signature", "timestamp", "recvWindow":
+		default:
+			params[key] = r.URL.Query().Get(key)
+		}
+	}
+	return params
+}
+
+func TestMarginOrderParams(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(bc *Client) error
+		wantMethod string
+		wantPath   string
+		wantParams map[string]string
+	}{
+		{
+			name: "isolated limit order",
+			call: func(bc *Client) error {
+				_, _, err := bc.CreateMarginOrder(MarginOrderRequest{
+					Symbol:         "BTCUSDT",
+					IsIsolated:     true,
+					Side:           "BUY",
+					Type:           "LIMIT",
+					TimeInForce:    "GTC",
+					Quantity:       decimal.RequireFromString("0.01"),
+					Price:          decimal.RequireFromString("30000"),
+					SideEffectType: MarginBuy,
+				})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/sapi/v1/margin/order",
+			wantParams: map[string]string{
+				"symbol": "BTCUSDT", "isIsolated": "TRUE", "side": "BUY", "type": "LIMIT", "timeInForce": "GTC",
+				"quantity": "0.01", "price": "30000", "sideEffectType": "MARGIN_BUY",
+			},
+		},
+		{
+			name: "cross market order by quote quantity",
+			call: func(bc *Client) error {
+				_, _, err := bc.CreateMarginOrder(MarginOrderRequest{
+					Symbol:        "BTCUSDT",
+					Side:          "SELL",
+					Type:          "MARKET",
+					QuoteOrderQty: decimal.NewFromInt(100),
+				})
+				return err
+			},
+			wantMethod: http.MethodPost,
+			wantPath:   "/sapi/v1/margin/order",
+			wantParams: map[string]string{"symbol": "BTCUSDT", "side": "SELL", "type": "MARKET", "quoteOrderQty": "100"},
+		},
+		{
+			name: "cancel by client order id",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelMarginOrder("BTCUSDT", false, 0, "my-order")
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/sapi/v1/margin/order",
+			wantParams: map[string]string{"symbol": "BTCUSDT", "origClientOrderId": "my-order"},
+		},
+		{
+			name: "query by order id",
+			call: func(bc *Client) error {
+				_, _, err := bc.GetMarginOrder("BTCUSDT", true, 42, "")
+				return err
+			},
+			wantMethod: http.MethodGet,
+			wantPath:   "/sapi/v1/margin/order",
+			wantParams: map[string]string{"symbol": "BTCUSDT", "isIsolated": "TRUE", "orderId": "42"},
+		},
+		{
+			name: "cancel OCO by order list id",
+			call: func(bc *Client) error {
+				_, _, err := bc.CancelMarginOCO("BTCUSDT", false, 7, "")
+				return err
+			},
+			wantMethod: http.MethodDelete,
+			wantPath:   "/sapi/v1/margin/orderList",
+			wantParams: map[string]string{"symbol": "BTCUSDT", "orderListId": "7"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.Method != tt.wantMethod || r.URL.Path != tt.wantPath {
+					t.Errorf("request = %s %s, want %s %s", r.Method, r.URL.Path, tt.wantMethod, tt.wantPath)
+				}
+				if got := signedParams(r); !reflect.DeepEqual(got, tt.wantParams) {
+					t.Errorf("params = %v, want %v", got, tt.wantParams)
+				}
+				writeJSON(t, w, map[string]interface{}{})
+			})
+			if err := tt.call(bc); err != nil {
+				t.Fatal(err)
+			}
+			if calls != 1 {
+				t.Errorf("%d requests sent, want 1", calls)
+			}
+		})
+	}
+}
+
+func TestGetMarginAllOrders(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sapi/v1/margin/allOrders" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		want := map[string]string{"symbol": "BNBBTC", "startTime": "1000", "endTime": "2000", "limit": "10"}
+		if got := signedParams(r); !reflect.DeepEqual(got, want) {
+			t.Errorf("params = %v, want %v", got, want)
+		}
+		_, _ = w.Write([]byte(`[{"clientOrderId":"D2KDy4DIeS56PvkM13f8cP","cummulativeQuoteQty":"0.00000000",
+			"executedQty":"0.00000000","icebergQty":"0.00000000","isWorking":false,"orderId":41295,
+			"origQty":"5.31000000","price":"0.22500000","side":"SELL","status":"CANCELED","stopPrice":"0.18000000",
+			"symbol":"BNBBTC","isIsolated":false,"time":1565769338806,"timeInForce":"GTC","type":"TAKE_PROFIT_LIMIT",
+			"selfTradePreventionMode":"NONE","updateTime":1565769342148}]`))
+	})
+	orders, _, err := bc.GetMarginAllOrders("BNBBTC", false, 0, 1000, 2000, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 {
+		t.Fatalf("%d orders, want 1", len(orders))
+	}
+	o := orders[0]
+	if o.OrderID != 41295 || o.Status != "CANCELED" || o.Type != "TAKE_PROFIT_LIMIT" || o.UpdateTime != 1565769342148 ||
+		!o.OrigQty.Equal(decimal.RequireFromString("5.31")) || !o.StopPrice.Equal(decimal.RequireFromString("0.18")) {
+		t.Errorf("GetMarginAllOrders() = %+v", o)
+	}
+}
+
+func TestCreateMarginOrderFills(t *testing.T) {
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"6gCrw2kRUAF9CvJDGP16IP",
+			"transactTime":1507725176595,"price":"0.00000000","origQty":"10.00000000","executedQty":"10.00000000",
+			"cummulativeQuoteQty":"10.00000000","status":"FILLED","timeInForce":"GTC","type":"MARKET","side":"SELL",
+			"marginBuyBorrowAmount":5,"marginBuyBorrowAsset":"BTC","isIsolated":true,
+			"fills":[{"price":"4000.00000000","qty":"1.00000000","commission":"4.00000000","commissionAsset":"USDT","tradeId":56}]}`))
+	})
+	o, _, err := bc.CreateMarginOrder(MarginOrderRequest{Symbol: "BTCUSDT", Side: "SELL", Type: "MARKET", Quantity: decimal.NewFromInt(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.OrderID != 28 || !o.IsIsolated || !o.MarginBuyBorrowAmount.Equal(decimal.NewFromInt(5)) || len(o.Fills) != 1 ||
+		!o.Fills[0].Price.Equal(decimal.NewFromInt(4000)) || o.Fills[0].TradeID != 56 {
+		t.Errorf("CreateMarginOrder() = %+v", o)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"net/url"
 	"strconv"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // RequestBuilder ...
@@ -42,6 +44,12 @@ func (r *RequestBuilder) WithParam(key, value string) *RequestBuilder {
 	return r
 }
 
+// WithParamValues set a param repeated once per value
+func (r *RequestBuilder) WithParamValues(key string, values ...string) *RequestBuilder {
+	r.params[key] = append([]string(nil), values...)
+	return r
+}
+
 // SignedRequest sign request with secret key
 func (r *RequestBuilder) SignedRequest(secret string) *http.Request {
 	r.params.Set("timestamp", strconv.FormatUint(currentMillis(), 10))
@@ -58,6 +66,56 @@ func (r *RequestBuilder) Request() *http.Request {
 	return r.req
 }
 
+func withOptionalParam(rb *RequestBuilder, key, value string) *RequestBuilder {
+	setOptionalParam(rb.params, key, value)
+	return rb
+}
+
+func withDecimalParam(rb *RequestBuilder, key string, value decimal.Decimal) *RequestBuilder {
+	setDecimalParam(rb.params, key, value)
+	return rb
+}
+
+// withParams add all values of params, e.g. built by a request type for both single and batch requests
+func withParams(rb *RequestBuilder, params url.Values) *RequestBuilder {
+	for key := range params {
+		rb = rb.WithParam(key, params.Get(key))
+	}
+	return rb
+}
+
+func setOptionalParam(params url.Values, key, value string) {
+	if value != "" {
+		params.Set(key, value)
+	}
+}
+
+func setDecimalParam(params url.Values, key string, value decimal.Decimal) {
+	if !value.IsZero() {
+		params.Set(key, value.String())
+	}
+}
+
+func withOrderID(rb *RequestBuilder, orderID int64, origClientOrderID string) *RequestBuilder {
+	if orderID != 0 {
+		rb = rb.WithParam("orderId", strconv.FormatInt(orderID, 10))
+	}
+	return withOptionalParam(rb, "origClientOrderId", origClientOrderID)
+}
+
+func withTimeRangeParams(rb *RequestBuilder, startTime, endTime int64, limit int) *RequestBuilder {
+	if startTime != 0 {
+		rb = rb.WithParam("startTime", strconv.FormatInt(startTime, 10))
+	}
+	if endTime != 0 {
+		rb = rb.WithParam("endTime", strconv.FormatInt(endTime, 10))
+	}
+	if limit > 0 {
+		rb = rb.WithParam("limit", strconv.Itoa(limit))
+	}
+	return rb
+}
+
 func sign(msg, secret string) string {
 	mac := hmac.New(sha256.New, []byte(secret))
 	if _, err := mac.Write([]byte(msg)); err != nil {
@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// TransferType is the direction of a universal transfer, FROM_TO. Types binance adds later can be used as
+// TransferType("FROM_TO")
+type TransferType string
+
+const (
+	TransferMainUMFuture                 TransferType = "MAIN_UMFUTURE"
+	TransferMainCMFuture                 TransferType = "MAIN_CMFUTURE"
+	TransferMainMargin                   TransferType = "MAIN_MARGIN"
+	TransferMainFunding                  TransferType = "MAIN_FUNDING"
+	TransferMainOption                   TransferType = "MAIN_OPTION"
+	TransferUMFutureMain                 TransferType = "UMFUTURE_MAIN"
+	TransferUMFutureMargin               TransferType = "UMFUTURE_MARGIN"
+	TransferUMFutureFunding              TransferType = "UMFUTURE_FUNDING"
+	TransferUMFutureOption               TransferType = "UMFUTURE_OPTION"
+	TransferCMFutureMain                 TransferType = "CMFUTURE_MAIN"
+	TransferCMFutureMargin               TransferType = "CMFUTURE_MARGIN"
+	TransferCMFutureFunding              TransferType = "CMFUTURE_FUNDING"
+	TransferMarginMain                   TransferType = "MARGIN_MAIN"
+	TransferMarginUMFuture               TransferType = "MARGIN_UMFUTURE"
+	TransferMarginCMFuture               TransferType = "MARGIN_CMFUTURE"
+	TransferMarginIsolatedMargin         TransferType = "MARGIN_ISOLATEDMARGIN"
+	TransferMarginFunding                TransferType = "MARGIN_FUNDING"
+	TransferMarginOption                 TransferType = "MARGIN_OPTION"
+	TransferIsolatedMarginMargin         TransferType = "ISOLATEDMARGIN_MARGIN"
+	TransferIsolatedMarginIsolatedMargin TransferType = "ISOLATEDMARGIN_ISOLATEDMARGIN"
+	TransferFundingMain                  TransferType = "FUNDING_MAIN"
+	TransferFundingUMFuture              TransferType = "FUNDING_UMFUTURE"
+	TransferFundingCMFuture              TransferType = "FUNDING_CMFUTURE"
+	TransferFundingMargin                TransferType = "FUNDING_MARGIN"
+	TransferFundingOption                TransferType = "FUNDING_OPTION"
+	TransferOptionMain                   TransferType = "OPTION_MAIN"
+	TransferOptionUMFuture               TransferType = "OPTION_UMFUTURE"
+	TransferOptionMargin                 TransferType = "OPTION_MARGIN"
+	TransferOptionFunding                TransferType = "OPTION_FUNDING"
+	TransferMainPortfolioMargin          TransferType = "MAIN_PORTFOLIO_MARGIN"
+	TransferPortfolioMarginMain          TransferType = "PORTFOLIO_MARGIN_MAIN"
+)
+
+// transferTypes is the documented transfer types between the wallets of WalletType
+var transferTypes = map[TransferType]bool{
+	TransferMainUMFuture:                 true,
+	TransferMainCMFuture:                 true,
+	TransferMainMargin:                   true,
+	TransferMainFunding:                  true,
+	TransferMainOption:                   true,
+	TransferUMFutureMain:                 true,
+	TransferUMFutureMargin:               true,
+	TransferUMFutureFunding:              true,
+	TransferUMFutureOption:               true,
+	TransferCMFutureMain:                 true,
+	TransferCMFutureMargin:               true,
+	TransferCMFutureFunding:              true,
+	TransferMarginMain:                   true,
+	TransferMarginUMFuture:               true,
+	TransferMarginCMFuture:               true,
+	TransferMarginIsolatedMargin:         true,
+	TransferMarginFunding:                true,
+	TransferMarginOption:                 true,
+	TransferIsolatedMarginMargin:         true,
+	TransferIsolatedMarginIsolatedMargin: true,
+	TransferFundingMain:                  true,
+	TransferFundingUMFuture:              true,
+	TransferFundingCMFuture:              true,
+	TransferFundingMargin:                true,
+	TransferFundingOption:                true,
+	TransferOptionMain:                   true,
+	TransferOptionUMFuture:               true,
+	TransferOptionMargin:                 true,
+	TransferOptionFunding:                true,
+}
+
+// NewTransferType return the universal transfer type moving funds from a wallet to another, it fails if binance does
+// not support that direction
+func NewTransferType(from, to WalletType) (TransferType, error) {
+	t := TransferType(transferWalletName(from) + "_" + transferWalletName(to))
+	if !transferTypes[t] {
+		return "", fmt.Errorf("unsupported transfer from %s to %s", from, to)
+	}
+	return t, nil
+}
+
+func transferWalletName(w WalletType) string {
+	switch w {
+	case SpotWallet:
+		return "MAIN"
+	case IsolatedMarginWallet:
+		return "ISOLATEDMARGIN"
+	}
+	return w.String()
+}
+
+// UniversalTransferRequest ...
+// FromSymbol and ToSymbol are required for transfers from and to isolated margin accounts.
+type UniversalTransferRequest struct {
+	Type       TransferType
+	Asset      string
+	Amount     decimal.Decimal
+	FromSymbol string
+	ToSymbol   string
+}
+
+// UniversalTransferQuery ...
+type UniversalTransferQuery struct {
+	Type       TransferType
+	StartTime  int64
+	EndTime    int64
+	Current    int
+	Size       int
+	FromSymbol string
+	ToSymbol   string
+}
+
+// UniversalTransferRecord ...
+type UniversalTransferRecord struct {
+	Asset     string          `json:"asset"`
+	Amount    decimal.Decimal `json:"amount"`
+	Type      TransferType    `json:"type"`
+	Status    string          `json:"status"`
+	TranID    uint64          `json:"tranId"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+// UniversalTransfer move funds between the wallets of the account, r.Type is sent as is so binance validates it
+func (bc *Client) UniversalTransfer(r UniversalTransferRequest) (uint64, *FwdData, error) {
+	var (
+		result marginCommonResult
+	)
+	if r.Type == "" {
+		return 0, nil, fmt.Errorf("transfer type is required")
+	}
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/transfer", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("type", string(r.Type)).
+		WithParam("asset", r.Asset).
+		WithParam("amount", r.Amount.String())
+	rr = withOptionalParam(rr, "fromSymbol", r.FromSymbol)
+	rr = withOptionalParam(rr, "toSymbol", r.ToSymbol)
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	if err != nil {
+		return 0, fwd, err
+	}
+	return result.TranID, fwd, err
+}
+
+// GetUniversalTransferHistory return universal transfers of a type and the total number of records
+func (bc *Client) GetUniversalTransferHistory(q UniversalTransferQuery) ([]UniversalTransferRecord, int64, *FwdData, error) {
+	var (
+		result struct {
+			Rows  []UniversalTransferRecord `json:"rows"`
+			Total int64                     `json:"total"`
+		}
+	)
+	requestURL := fmt.Sprintf("%s/sapi/v1/asset/transfer", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("type", string(q.Type))
+	rr = withTimeRangeParams(rr, q.StartTime, q.EndTime, 0)
+	if q.Current > 0 {
+		rr = rr.WithParam("current", strconv.Itoa(q.Current))
+	}
+	if q.Size > 0 {
+		rr = rr.WithParam("size", strconv.Itoa(q.Size))
+	}
+	rr = withOptionalParam(rr, "fromSymbol", q.FromSymbol)
+	rr = withOptionalParam(rr, "toSymbol", q.ToSymbol)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result.Rows, result.Total, fwd, err
+}
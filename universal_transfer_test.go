@@ -0,0 +1,76 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewTransferType(t *testing.T) {
+	tests := []struct {
+		from, to WalletType
+		want     TransferType
+		wantErr  bool
+	}{
+		{from: SpotWallet, to: USDMFutureWallet, want: TransferMainUMFuture},
+		{from: FundingWallet, to: SpotWallet, want: TransferFundingMain},
+		{from: CrossMarginWallet, to: IsolatedMarginWallet, want: TransferMarginIsolatedMargin},
+		{from: IsolatedMarginWallet, to: IsolatedMarginWallet, want: TransferIsolatedMarginIsolatedMargin},
+		{from: OptionWallet, to: FundingWallet, want: TransferOptionFunding},
+		{from: SpotWallet, to: SpotWallet, wantErr: true},
+		{from: CoinMFutureWallet, to: OptionWallet, wantErr: true},
+		{from: IsolatedMarginWallet, to: SpotWallet, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from.String()+"_"+tt.to.String(), func(t *testing.T) {
+			got, err := NewTransferType(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewTransferType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("NewTransferType() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniversalTransfer(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     TransferType
+		wantErr bool
+	}{
+		{name: "known type", typ: TransferMainPortfolioMargin},
+		{name: "type without a constant is passed through", typ: TransferType("MAIN_NEWWALLET")},
+		{name: "missing type", typ: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if r.URL.Path != "/sapi/v1/asset/transfer" || r.Method != http.MethodPost {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("type"); got != string(tt.typ) {
+					t.Errorf("type = %s, want %s", got, tt.typ)
+				}
+				writeJSON(t, w, map[string]interface{}{"tranId": 13526853623})
+			})
+			id, _, err := bc.UniversalTransfer(UniversalTransferRequest{Type: tt.typ, Asset: "USDT", Amount: decimal.NewFromInt(10)})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UniversalTransfer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if calls != 0 {
+					t.Errorf("%d requests sent, want none", calls)
+				}
+				return
+			}
+			if id != 13526853623 {
+				t.Errorf("UniversalTransfer() = %d, want 13526853623", id)
+			}
+		})
+	}
+}
@@ -10,11 +10,16 @@ func _() {
 	var x [1]struct{}
 	_ = x[SpotWallet-1]
 	_ = x[IsolatedMarginWallet-2]
+	_ = x[FundingWallet-3]
+	_ = x[USDMFutureWallet-4]
+	_ = x[CoinMFutureWallet-5]
+	_ = x[CrossMarginWallet-6]
+	_ = x[OptionWallet-7]
 }
 
-const _WalletType_name = "SPOTISOLATED_MARGIN"
+const _WalletType_name = "SPOTISOLATED_MARGINFUNDINGUMFUTURECMFUTUREMARGINOPTION"
 
-var _WalletType_index = [...]uint8{0, 4, 19}
+var _WalletType_index = [...]uint8{0, 4, 19, 26, 34, 42, 48, 54}
 
 func (i WalletType) String() string {
 	i -= 1
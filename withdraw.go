@@ -0,0 +1,153 @@
+package binance
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrAddressRegexUnsupported is returned by WithdrawRequest.Validate when the address or tag regex of the network can
+// not be compiled, binance regexes may use perl syntax unsupported by RE2 such as lookaheads
+var ErrAddressRegexUnsupported = errors.New("address regex is not supported")
+
+// WithdrawRequest is a withdrawal, zero values are not sent.
+// Wallet is the wallet the funds are taken from, SpotWallet or FundingWallet, binance uses the spot wallet by default.
+// TransactionFeeFlag set to true makes the receiver pay the fee when withdrawing to a binance account.
+// SkipUnsupportedRegex is not sent, set to true it skips the address and tag checks failing with
+// ErrAddressRegexUnsupported, leaving them to binance.
+type WithdrawRequest struct {
+	Coin               string
+	Network            string
+	Address            string
+	AddressTag         string
+	Amount             decimal.Decimal
+	WithdrawOrderID    string
+	Name               string
+	Wallet             WalletType
+	TransactionFeeFlag bool
+
+	SkipUnsupportedRegex bool
+}
+
+// Validate check the withdrawal against the network info of the coin, an empty network is the default one.
+// ErrAddressRegexUnsupported is only returned once every other check passed.
+func (r WithdrawRequest) Validate(coin CoinInfo) error {
+	if coin.Coin != r.Coin {
+		return fmt.Errorf("coin info of %s given for a %s withdrawal", coin.Coin, r.Coin)
+	}
+	network, ok := coin.FindNetwork(r.Network)
+	if !ok {
+		return fmt.Errorf("network %q not found for %s", r.Network, r.Coin)
+	}
+	if !network.WithdrawEnable {
+		return fmt.Errorf("withdrawal of %s on %s is disabled", r.Coin, network.Network)
+	}
+	if !r.Amount.IsPositive() {
+		return fmt.Errorf("invalid withdrawal amount %s", r.Amount)
+	}
+	if network.WithdrawMin != "" {
+		min, err := decimal.NewFromString(network.WithdrawMin)
+		if err != nil {
+			return fmt.Errorf("invalid withdrawMin %q, %w", network.WithdrawMin, err)
+		}
+		if r.Amount.LessThan(min) {
+			return fmt.Errorf("withdrawal amount %s is below the %s minimum %s", r.Amount, network.Network, min)
+		}
+	}
+	if network.WithdrawMax != "" {
+		max, err := decimal.NewFromString(network.WithdrawMax)
+		if err != nil {
+			return fmt.Errorf("invalid withdrawMax %q, %w", network.WithdrawMax, err)
+		}
+		if max.IsPositive() && r.Amount.GreaterThan(max) {
+			return fmt.Errorf("withdrawal amount %s is above the %s maximum %s", r.Amount, network.Network, max)
+		}
+	}
+	var unsupported error
+	if err := matchRegex("address", network.AddressRegex, r.Address); err != nil {
+		if !errors.Is(err, ErrAddressRegexUnsupported) {
+			return err
+		}
+		unsupported = err
+	}
+	if network.SameAddress && r.AddressTag == "" {
+		return fmt.Errorf("an address tag is required to withdraw %s on %s", r.Coin, network.Network)
+	}
+	if r.AddressTag != "" {
+		if err := matchRegex("address tag", network.MemoRegex, r.AddressTag); err != nil {
+			if !errors.Is(err, ErrAddressRegexUnsupported) {
+				return err
+			}
+			unsupported = err
+		}
+	}
+	switch r.Wallet {
+	case 0, SpotWallet, FundingWallet:
+	default:
+		return fmt.Errorf("can not withdraw from %s wallet", r.Wallet)
+	}
+	if r.SkipUnsupportedRegex {
+		return nil
+	}
+	return unsupported
+}
+
+func matchRegex(field, pattern, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if pattern == "" {
+		return nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("%w, %s regex %q: %v", ErrAddressRegexUnsupported, field, pattern, err)
+	}
+	if !re.MatchString(value) {
+		return fmt.Errorf("malformed %s %q", field, value)
+	}
+	return nil
+}
+
+// CreateWithdraw validate the withdrawal against the coin info and submit it, it return the withdrawal id
+func (bc *Client) CreateWithdraw(r WithdrawRequest, coin CoinInfo) (string, *FwdData, error) {
+	if err := r.Validate(coin); err != nil {
+		return "", nil, err
+	}
+	return bc.withdraw(r)
+}
+
+func (bc *Client) withdraw(r WithdrawRequest) (string, *FwdData, error) {
+	var result WithdrawResult
+	requestURL := fmt.Sprintf("%s/sapi/v1/capital/withdraw/apply", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodPost, requestURL, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey).
+		WithParam("coin", r.Coin).
+		WithParam("address", r.Address).
+		WithParam("amount", r.Amount.String())
+	rr = withOptionalParam(rr, "withdrawOrderId", r.WithdrawOrderID)
+	rr = withOptionalParam(rr, "network", r.Network)
+	rr = withOptionalParam(rr, "addressTag", r.AddressTag)
+	rr = withOptionalParam(rr, "name", r.Name)
+	if r.TransactionFeeFlag {
+		rr = rr.WithParam("transactionFeeFlag", strconv.FormatBool(r.TransactionFeeFlag))
+	}
+	switch r.Wallet {
+	case SpotWallet:
+		rr = rr.WithParam("walletType", "0")
+	case FundingWallet:
+		rr = rr.WithParam("walletType", "1")
+	}
+	fwd, err := bc.doMutatingRequest(rr.SignedRequest(bc.secretKey), &result, false)
+	if err != nil {
+		return "", fwd, err
+	}
+	return result.ID, fwd, err
+}
@@ -0,0 +1,322 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrWithdrawNotAllowed is wrapped by the errors of withdrawals blocked by a WithdrawGuard
+var ErrWithdrawNotAllowed = errors.New("withdrawal not allowed")
+
+const withdrawGuardWindow = 24 * time.Hour
+
+// WithdrawDecision is a step of a guarded withdrawal recorded in the audit trail
+type WithdrawDecision string
+
+const (
+	WithdrawRequested WithdrawDecision = "REQUESTED"
+	WithdrawRejected  WithdrawDecision = "REJECTED" // blocked by the policy
+	WithdrawDenied    WithdrawDecision = "DENIED"   // blocked by the approver
+	WithdrawApproved  WithdrawDecision = "APPROVED"
+	WithdrawSubmitted WithdrawDecision = "SUBMITTED"
+	WithdrawFailed    WithdrawDecision = "FAILED"
+)
+
+// WithdrawAuditEntry ...
+type WithdrawAuditEntry struct {
+	Time       time.Time
+	Request    WithdrawRequest
+	Decision   WithdrawDecision
+	Reason     string
+	Approver   string
+	WithdrawID string
+}
+
+// WithdrawAuditor receive every step of guarded withdrawals
+type WithdrawAuditor interface {
+	Record(entry WithdrawAuditEntry)
+}
+
+// WithdrawAuditFunc adapt a function to WithdrawAuditor
+type WithdrawAuditFunc func(entry WithdrawAuditEntry)
+
+// Record ...
+func (f WithdrawAuditFunc) Record(entry WithdrawAuditEntry) {
+	f(entry)
+}
+
+// WithdrawApprover decide whether a withdrawal which passed the policy can be sent, it may block until an operator
+// answers or ctx is done. It returns the approver identity for the audit trail.
+type WithdrawApprover interface {
+	Approve(ctx context.Context, r WithdrawRequest) (approved bool, approver string, err error)
+}
+
+// WithdrawLimit caps the withdrawals of an asset, a zero value means no cap
+type WithdrawLimit struct {
+	PerWithdraw decimal.Decimal
+	Rolling24h  decimal.Decimal
+}
+
+// guardedWithdrawal is a withdrawal counted in the rolling caps, id is empty until binance accepted it
+type guardedWithdrawal struct {
+	id     string
+	time   time.Time
+	coin   string
+	amount decimal.Decimal
+}
+
+// WithdrawGuard enforce a withdrawal policy in front of CreateWithdraw: only whitelisted addresses, per-asset and
+// rolling 24h caps, and an optional approval.
+// The withdrawals counted in the rolling caps are only kept in memory, a new guard does not know what was withdrawn
+// before it was created, e.g. by a previous process, until LoadHistory is called.
+type WithdrawGuard struct {
+	bc       *Client
+	approver WithdrawApprover
+	auditor  WithdrawAuditor
+
+	mu        sync.Mutex
+	whitelist map[string]bool
+	limits    map[string]WithdrawLimit
+	sent      []guardedWithdrawal
+}
+
+// NewWithdrawGuard create a guard which rejects every withdrawal until addresses are whitelisted, approver and auditor
+// are optional
+func (bc *Client) NewWithdrawGuard(approver WithdrawApprover, auditor WithdrawAuditor) *WithdrawGuard {
+	return &WithdrawGuard{
+		bc:        bc,
+		approver:  approver,
+		auditor:   auditor,
+		whitelist: make(map[string]bool),
+		limits:    make(map[string]WithdrawLimit),
+	}
+}
+
+// AllowAddress whitelist an address and tag of a coin network, they are compared exactly
+func (g *WithdrawGuard) AllowAddress(coin, network, address, addressTag string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.whitelist[whitelistKey(coin, network, address, addressTag)] = true
+}
+
+// RemoveAddress remove an address from the whitelist
+func (g *WithdrawGuard) RemoveAddress(coin, network, address, addressTag string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.whitelist, whitelistKey(coin, network, address, addressTag))
+}
+
+// SetLimit set the caps of coin withdrawals
+func (g *WithdrawGuard) SetLimit(coin string, limit WithdrawLimit) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.limits[coin] = limit
+}
+
+// Withdrawn return the amount of coin withdrawn through the guard in the last 24h
+func (g *WithdrawGuard) Withdrawn(coin string) decimal.Decimal {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.withdrawnLocked(coin, time.Now())
+}
+
+// LoadHistory count the withdrawals binance reports for the last 24h in the rolling caps, e.g. at startup.
+// Cancelled, rejected and failed withdrawals are skipped, as are withdrawals already counted by the guard.
+func (g *WithdrawGuard) LoadHistory() error {
+	now := time.Now()
+	withdrawals, _, err := g.bc.GetWithdrawHistory(WithdrawHistoryQuery{
+		StartTime: now.Add(-withdrawGuardWindow).UnixNano() / int64(time.Millisecond),
+		EndTime:   now.UnixNano() / int64(time.Millisecond),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get withdraw history, %w", err)
+	}
+	var loaded []guardedWithdrawal
+	for _, w := range withdrawals {
+		switch w.Status {
+		case WithdrawStatusCancelled, WithdrawStatusRejected, WithdrawStatusFailure:
+			continue
+		}
+		amount, err := decimal.NewFromString(w.Amount)
+		if err != nil {
+			return fmt.Errorf("invalid amount %q of withdrawal %s, %w", w.Amount, w.ID, err)
+		}
+		loaded = append(loaded, guardedWithdrawal{id: w.ID, time: w.ApplyTime.Time, coin: w.Coin, amount: amount})
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	known := make(map[string]bool, len(g.sent))
+	for _, w := range g.sent {
+		if w.id != "" {
+			known[w.id] = true
+		}
+	}
+	for _, w := range loaded {
+		if !known[w.id] {
+			known[w.id] = true
+			g.sent = append(g.sent, w)
+		}
+	}
+	return nil
+}
+
+// Withdraw check r against the coin info and the policy, ask for approval then submit it. The network must be set so
+// the whitelist is not bypassed by the default network.
+func (g *WithdrawGuard) Withdraw(ctx context.Context, r WithdrawRequest, coin CoinInfo) (string, *FwdData, error) {
+	g.audit(r, WithdrawRequested, "", "", "")
+	if err := r.Validate(coin); err != nil {
+		return "", nil, g.reject(r, err.Error())
+	}
+	if r.Network == "" {
+		return "", nil, g.reject(r, "network is required")
+	}
+	g.mu.Lock()
+	reason := g.checkLocked(r, time.Now())
+	g.mu.Unlock()
+	if reason != "" {
+		return "", nil, g.reject(r, reason)
+	}
+
+	approver := ""
+	if g.approver != nil {
+		approved, who, err := g.approver.Approve(ctx, r)
+		approver = who
+		if err != nil || !approved {
+			reason := "not approved"
+			if err != nil {
+				reason = fmt.Sprintf("approval failed, %v", err)
+			}
+			g.audit(r, WithdrawDenied, reason, approver, "")
+			return "", nil, fmt.Errorf("%w: %s", ErrWithdrawNotAllowed, reason)
+		}
+		g.audit(r, WithdrawApproved, "", approver, "")
+	}
+
+	// limits are checked again as other withdrawals may have been sent while waiting for approval, the amount is
+	// reserved before sending so concurrent withdrawals can not exceed the caps
+	now := time.Now()
+	g.mu.Lock()
+	reason = g.checkLocked(r, now)
+	reservation := guardedWithdrawal{time: now, coin: r.Coin, amount: r.Amount}
+	if reason == "" {
+		g.sent = append(g.sent, reservation)
+	}
+	g.mu.Unlock()
+	if reason != "" {
+		return "", nil, g.reject(r, reason)
+	}
+
+	id, fwd, err := g.bc.withdraw(r)
+	if err != nil {
+		// binance may have accepted the withdrawal on a transport error, a timeout or a server error, the amount is
+		// only released when binance definitely rejected it
+		if isDefiniteRejection(fwd, err) {
+			g.release(reservation)
+		}
+		g.audit(r, WithdrawFailed, err.Error(), approver, "")
+		return "", fwd, err
+	}
+	g.setID(reservation, id)
+	g.audit(r, WithdrawSubmitted, "", approver, id)
+	return id, fwd, nil
+}
+
+// checkLocked return why r breaks the policy or an empty string, must be called with mu held
+func (g *WithdrawGuard) checkLocked(r WithdrawRequest, now time.Time) string {
+	if !g.whitelist[whitelistKey(r.Coin, r.Network, r.Address, r.AddressTag)] {
+		return fmt.Sprintf("address %s is not whitelisted for %s on %s", r.Address, r.Coin, r.Network)
+	}
+	limit := g.limits[r.Coin]
+	if limit.PerWithdraw.IsPositive() && r.Amount.GreaterThan(limit.PerWithdraw) {
+		return fmt.Sprintf("amount %s is above the %s per withdrawal cap %s", r.Amount, r.Coin, limit.PerWithdraw)
+	}
+	if limit.Rolling24h.IsPositive() {
+		withdrawn := g.withdrawnLocked(r.Coin, now)
+		if withdrawn.Add(r.Amount).GreaterThan(limit.Rolling24h) {
+			return fmt.Sprintf("amount %s on top of %s withdrawn in 24h is above the %s cap %s",
+				r.Amount, withdrawn, r.Coin, limit.Rolling24h)
+		}
+	}
+	return ""
+}
+
+// withdrawnLocked drop withdrawals out of the rolling window and sum the remaining ones of coin
+func (g *WithdrawGuard) withdrawnLocked(coin string, now time.Time) decimal.Decimal {
+	kept := g.sent[:0]
+	total := decimal.Zero
+	for _, w := range g.sent {
+		if now.Sub(w.time) >= withdrawGuardWindow {
+			continue
+		}
+		kept = append(kept, w)
+		if w.coin == coin {
+			total = total.Add(w.amount)
+		}
+	}
+	g.sent = kept
+	return total
+}
+
+func (g *WithdrawGuard) release(reservation guardedWithdrawal) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if i := g.indexLocked(reservation); i >= 0 {
+		g.sent = append(g.sent[:i], g.sent[i+1:]...)
+	}
+}
+
+// setID record the id binance gave to a reserved withdrawal so LoadHistory does not count it twice
+func (g *WithdrawGuard) setID(reservation guardedWithdrawal, id string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if i := g.indexLocked(reservation); i >= 0 {
+		g.sent[i].id = id
+	}
+}
+
+func (g *WithdrawGuard) indexLocked(reservation guardedWithdrawal) int {
+	for i, w := range g.sent {
+		if w.id == "" && w.time.Equal(reservation.time) && w.coin == reservation.coin && w.amount.Equal(reservation.amount) {
+			return i
+		}
+	}
+	return -1
+}
+
+// isDefiniteRejection return true if err is a binance error code on a client error response
+func isDefiniteRejection(fwd *FwdData, err error) bool {
+	apiErr, ok := ToAPIError(err)
+	if !ok || apiErr.Code == 0 || fwd == nil {
+		return false
+	}
+	return fwd.Status >= http.StatusBadRequest && fwd.Status < http.StatusInternalServerError
+}
+
+func (g *WithdrawGuard) reject(r WithdrawRequest, reason string) error {
+	g.audit(r, WithdrawRejected, reason, "", "")
+	return fmt.Errorf("%w: %s", ErrWithdrawNotAllowed, reason)
+}
+
+func (g *WithdrawGuard) audit(r WithdrawRequest, decision WithdrawDecision, reason, approver, withdrawID string) {
+	if g.auditor == nil {
+		return
+	}
+	g.auditor.Record(WithdrawAuditEntry{
+		Time:       time.Now(),
+		Request:    r,
+		Decision:   decision,
+		Reason:     reason,
+		Approver:   approver,
+		WithdrawID: withdrawID,
+	})
+}
+
+func whitelistKey(coin, network, address, addressTag string) string {
+	return coin + "|" + network + "|" + address + "|" + addressTag
+}
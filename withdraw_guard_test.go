@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func testWithdrawCoin() CoinInfo {
+	return CoinInfo{
+		Coin: "USDT",
+		NetworkList: []CoinNetwork{{
+			Coin:           "USDT",
+			Network:        "TRX",
+			IsDefault:      true,
+			WithdrawEnable: true,
+			WithdrawMin:    "1",
+			WithdrawMax:    "10000",
+		}},
+	}
+}
+
+func testWithdrawRequest(address string, amount int64) WithdrawRequest {
+	return WithdrawRequest{Coin: "USDT", Network: "TRX", Address: address, Amount: decimal.NewFromInt(amount)}
+}
+
+func TestWithdrawGuardReservation(t *testing.T) {
+	tests := []struct {
+		name      string
+		respond   func(w http.ResponseWriter)
+		wantErr   bool
+		withdrawn int64
+	}{
+		{
+			name: "submitted",
+			respond: func(w http.ResponseWriter) {
+				_, _ = w.Write([]byte(`{"id":"w1"}`))
+			},
+			withdrawn: 100,
+		},
+		{
+			name: "rejected by binance",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"code":-4026,"msg":"User has insufficient balance"}`))
+			},
+			wantErr:   true,
+			withdrawn: 0,
+		},
+		{
+			name: "server error",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"code":-1000,"msg":"An unknown error occurred"}`))
+			},
+			wantErr:   true,
+			withdrawn: 100,
+		},
+		{
+			name: "bad request without code",
+			respond: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`<html>bad request</html>`))
+			},
+			wantErr:   true,
+			withdrawn: 100,
+		},
+		{
+			name: "connection closed",
+			respond: func(w http.ResponseWriter) {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err == nil {
+					_ = conn.Close()
+				}
+			},
+			wantErr:   true,
+			withdrawn: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sapi/v1/capital/withdraw/apply" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				tt.respond(w)
+			})
+			guard := bc.NewWithdrawGuard(nil, nil)
+			guard.AllowAddress("USDT", "TRX", "Taddress", "")
+			_, _, err := guard.Withdraw(context.Background(), testWithdrawRequest("Taddress", 100), testWithdrawCoin())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Withdraw() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := guard.Withdrawn("USDT"); !got.Equal(decimal.NewFromInt(tt.withdrawn)) {
+				t.Errorf("Withdrawn() = %s, want %d", got, tt.withdrawn)
+			}
+		})
+	}
+}
+
+func TestWithdrawGuardPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		amounts []int64
+		wantErr []bool
+	}{
+		{name: "not whitelisted", address: "Tother", amounts: []int64{10}, wantErr: []bool{true}},
+		{name: "above per withdrawal cap", address: "Taddress", amounts: []int64{600}, wantErr: []bool{true}},
+		{name: "within rolling cap", address: "Taddress", amounts: []int64{500, 500}, wantErr: []bool{false, false}},
+		{name: "above rolling cap", address: "Taddress", amounts: []int64{500, 400, 200}, wantErr: []bool{false, false, true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				writeJSON(t, w, map[string]string{"id": "w"})
+			})
+			guard := bc.NewWithdrawGuard(nil, nil)
+			guard.AllowAddress("USDT", "TRX", "Taddress", "")
+			guard.SetLimit("USDT", WithdrawLimit{PerWithdraw: decimal.NewFromInt(500), Rolling24h: decimal.NewFromInt(1000)})
+			wantCalls := 0
+			for i, amount := range tt.amounts {
+				_, _, err := guard.Withdraw(context.Background(), testWithdrawRequest(tt.address, amount), testWithdrawCoin())
+				if (err != nil) != tt.wantErr[i] {
+					t.Fatalf("Withdraw(%d) error = %v, wantErr %v", amount, err, tt.wantErr[i])
+				}
+				if err != nil && !errors.Is(err, ErrWithdrawNotAllowed) {
+					t.Errorf("Withdraw(%d) error = %v, want ErrWithdrawNotAllowed", amount, err)
+				}
+				if err == nil {
+					wantCalls++
+				}
+			}
+			if calls != wantCalls {
+				t.Errorf("%d withdrawals sent, want %d", calls, wantCalls)
+			}
+		})
+	}
+}
+
+func TestWithdrawGuardLoadHistory(t *testing.T) {
+	applyTime := func(ago time.Duration) string {
+		return time.Now().Add(-ago).UTC().Format(dateTimeLayout)
+	}
+	bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sapi/v1/capital/withdraw/apply":
+			writeJSON(t, w, map[string]string{"id": "w1"})
+		case "/sapi/v1/capital/withdraw/history":
+			writeJSON(t, w, []map[string]interface{}{
+				{"id": "w1", "coin": "USDT", "amount": "100", "status": 4, "applyTime": applyTime(0)},
+				{"id": "w2", "coin": "USDT", "amount": "200", "status": 6, "applyTime": applyTime(time.Hour)},
+				{"id": "w3", "coin": "USDT", "amount": "400", "status": 1, "applyTime": applyTime(time.Hour)},
+				{"id": "w4", "coin": "USDT", "amount": "800", "status": 3, "applyTime": applyTime(time.Hour)},
+				{"id": "w5", "coin": "USDT", "amount": "1600", "status": 5, "applyTime": applyTime(time.Hour)},
+				{"id": "w6", "coin": "BTC", "amount": "1", "status": 6, "applyTime": applyTime(time.Hour)},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	})
+	guard := bc.NewWithdrawGuard(nil, nil)
+	guard.AllowAddress("USDT", "TRX", "Taddress", "")
+	if _, _, err := guard.Withdraw(context.Background(), testWithdrawRequest("Taddress", 100), testWithdrawCoin()); err != nil {
+		t.Fatal(err)
+	}
+	// loading twice counts each withdrawal once, w1 was already counted when it was sent
+	for i := 0; i < 2; i++ {
+		if err := guard.LoadHistory(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := guard.Withdrawn("USDT"); !got.Equal(decimal.NewFromInt(300)) {
+		t.Errorf("Withdrawn(USDT) = %s, want 300", got)
+	}
+	if got := guard.Withdrawn("BTC"); !got.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("Withdrawn(BTC) = %s, want 1", got)
+	}
+}
@@ -0,0 +1,435 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WithdrawStatus ...
+type WithdrawStatus int
+
+const (
+	WithdrawStatusEmailSent        WithdrawStatus = 0
+	WithdrawStatusCancelled        WithdrawStatus = 1
+	WithdrawStatusAwaitingApproval WithdrawStatus = 2
+	WithdrawStatusRejected         WithdrawStatus = 3
+	WithdrawStatusProcessing       WithdrawStatus = 4
+	WithdrawStatusFailure          WithdrawStatus = 5
+	WithdrawStatusCompleted        WithdrawStatus = 6
+)
+
+var withdrawStatusNames = map[WithdrawStatus]string{
+	WithdrawStatusEmailSent:        "EMAIL_SENT",
+	WithdrawStatusCancelled:        "CANCELLED",
+	WithdrawStatusAwaitingApproval: "AWAITING_APPROVAL",
+	WithdrawStatusRejected:         "REJECTED",
+	WithdrawStatusProcessing:       "PROCESSING",
+	WithdrawStatusFailure:          "FAILURE",
+	WithdrawStatusCompleted:        "COMPLETED",
+}
+
+func (s WithdrawStatus) String() string {
+	if name, ok := withdrawStatusNames[s]; ok {
+		return name
+	}
+	return "WithdrawStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+// IsFinal return true if the withdrawal will not change anymore
+func (s WithdrawStatus) IsFinal() bool {
+	switch s {
+	case WithdrawStatusCancelled, WithdrawStatusRejected, WithdrawStatusFailure, WithdrawStatusCompleted:
+		return true
+	}
+	return false
+}
+
+// DepositStatus ...
+type DepositStatus int
+
+const (
+	DepositStatusPending             DepositStatus = 0
+	DepositStatusSuccess             DepositStatus = 1
+	DepositStatusRejected            DepositStatus = 2
+	DepositStatusCreditedNoWithdraw  DepositStatus = 6 // credited but can not be withdrawn yet
+	DepositStatusWrongDeposit        DepositStatus = 7
+	DepositStatusWaitingConfirmation DepositStatus = 8 // waiting for the user to confirm
+)
+
+var depositStatusNames = map[DepositStatus]string{
+	DepositStatusPending:             "PENDING",
+	DepositStatusSuccess:             "SUCCESS",
+	DepositStatusRejected:            "REJECTED",
+	DepositStatusCreditedNoWithdraw:  "CREDITED_CANNOT_WITHDRAW",
+	DepositStatusWrongDeposit:        "WRONG_DEPOSIT",
+	DepositStatusWaitingConfirmation: "WAITING_USER_CONFIRM",
+}
+
+func (s DepositStatus) String() string {
+	if name, ok := depositStatusNames[s]; ok {
+		return name
+	}
+	return "DepositStatus(" + strconv.Itoa(int(s)) + ")"
+}
+
+const dateTimeLayout = "2006-01-02 15:04:05"
+
+// DateTime is a UTC time binance formats as "2006-01-02 15:04:05", the zero value is an empty string
+type DateTime struct {
+	time.Time
+}
+
+// UnmarshalJSON ...
+func (t *DateTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := time.ParseInLocation(dateTimeLayout, s, time.UTC)
+	if err != nil {
+		return fmt.Errorf("invalid date time %q, %w", s, err)
+	}
+	t.Time = parsed
+	return nil
+}
+
+// MarshalJSON ...
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return json.Marshal("")
+	}
+	return json.Marshal(t.UTC().Format(dateTimeLayout))
+}
+
+// WithdrawHistoryQuery is the filters of withdrawal history, zero values are not sent.
+// Status is a pointer as 0 is a valid status.
+type WithdrawHistoryQuery struct {
+	Coin            string
+	WithdrawOrderID string
+	Status          *WithdrawStatus
+	StartTime       int64
+	EndTime         int64
+	Offset          int
+	Limit           int
+	IDList          []string
+}
+
+// DepositHistoryQuery is the filters of deposit history, zero values are not sent.
+// Status is a pointer as 0 is a valid status.
+type DepositHistoryQuery struct {
+	Coin      string
+	Status    *DepositStatus
+	StartTime int64
+	EndTime   int64
+	Offset    int
+	Limit     int
+	TxID      string
+}
+
+// GetWithdrawHistory return withdrawals matching q
+func (bc *Client) GetWithdrawHistory(q WithdrawHistoryQuery) (WithdrawalsList, *FwdData, error) {
+	result := WithdrawalsList{}
+	requestURL := fmt.Sprintf("%s/sapi/v1/capital/withdraw/history", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey)
+	rr = withOptionalParam(rr, "coin", q.Coin)
+	rr = withOptionalParam(rr, "withdrawOrderId", q.WithdrawOrderID)
+	rr = withOptionalParam(rr, "idList", strings.Join(q.IDList, ","))
+	if q.Status != nil {
+		rr = rr.WithParam("status", strconv.Itoa(int(*q.Status)))
+	}
+	if q.Offset > 0 {
+		rr = rr.WithParam("offset", strconv.Itoa(q.Offset))
+	}
+	rr = withTimeRangeParams(rr, q.StartTime, q.EndTime, q.Limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// GetDepositHistory return deposits matching q
+func (bc *Client) GetDepositHistory(q DepositHistoryQuery) (DepositsList, *FwdData, error) {
+	result := DepositsList{}
+	requestURL := fmt.Sprintf("%s/sapi/v1/capital/deposit/hisrec", bc.apiBaseURL)
+	req, err := NewRequestBuilder(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return result, nil, err
+	}
+	rr := req.WithHeader(apiKeyHeader, bc.apiKey)
+	rr = withOptionalParam(rr, "coin", q.Coin)
+	rr = withOptionalParam(rr, "txId", q.TxID)
+	if q.Status != nil {
+		rr = rr.WithParam("status", strconv.Itoa(int(*q.Status)))
+	}
+	if q.Offset > 0 {
+		rr = rr.WithParam("offset", strconv.Itoa(q.Offset))
+	}
+	rr = withTimeRangeParams(rr, q.StartTime, q.EndTime, q.Limit)
+	fwd, err := bc.doRequest(rr.SignedRequest(bc.secretKey), &result)
+	return result, fwd, err
+}
+
+// defaultWithdrawNotFoundPolls is how many successful polls a withdrawal may be missing from the history
+const defaultWithdrawNotFoundPolls = 10
+
+// WithdrawTracker follow withdrawals by polling the withdrawal history until they reach a final status
+type WithdrawTracker struct {
+	bc       *Client
+	interval time.Duration
+
+	// MaxNotFoundPolls is how many successful polls may not return a withdrawal before Track gives up, polls that
+	// failed are not counted
+	MaxNotFoundPolls int
+	// OnTransition is called when the status of a tracked withdrawal changes, including when it is first seen
+	OnTransition func(entry WithdrawalEntry, previous *WithdrawStatus)
+	// OnError is called when the history can not be polled, tracking goes on
+	OnError func(error)
+}
+
+// NewWithdrawTracker create a tracker polling each interval
+func (bc *Client) NewWithdrawTracker(interval time.Duration) (*WithdrawTracker, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s", interval)
+	}
+	return &WithdrawTracker{
+		bc:               bc,
+		interval:         interval,
+		MaxNotFoundPolls: defaultWithdrawNotFoundPolls,
+	}, nil
+}
+
+// Track block until the withdrawal id returned by Withdraw reaches a final status or ctx is done, it returns the last
+// known entry. It gives up when the withdrawal is still not in the history after MaxNotFoundPolls polls.
+func (t *WithdrawTracker) Track(ctx context.Context, id string) (WithdrawalEntry, error) {
+	var (
+		last     WithdrawalEntry
+		previous *WithdrawStatus
+		notFound int
+	)
+	if id == "" {
+		return last, fmt.Errorf("withdrawal id is required")
+	}
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		entries, _, err := t.bc.GetWithdrawHistory(WithdrawHistoryQuery{IDList: []string{id}})
+		if err != nil {
+			if t.OnError != nil {
+				t.OnError(fmt.Errorf("failed to poll withdrawal %s, %w", id, err))
+			}
+		}
+		found := false
+		for _, entry := range entries {
+			if entry.ID != id {
+				continue
+			}
+			found = true
+			last = entry
+			if previous == nil || *previous != entry.Status {
+				if t.OnTransition != nil {
+					t.OnTransition(entry, previous)
+				}
+				status := entry.Status
+				previous = &status
+			}
+			if entry.Status.IsFinal() {
+				return entry, nil
+			}
+		}
+		if err == nil && !found && previous == nil {
+			notFound++
+			if notFound >= t.MaxNotFoundPolls {
+				return last, fmt.Errorf("withdrawal %s not found after %d polls", id, notFound)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+const (
+	capitalHistoryWindow   = 90 * 24 * time.Hour
+	maxCapitalHistoryLimit = 1000
+)
+
+// historyWindow walk a long time range by 90 days windows, each window being paged with offset
+type historyWindow struct {
+	start  int64
+	end    int64
+	last   int64
+	offset int
+	limit  int
+	done   bool
+}
+
+func newHistoryWindow(startTime, endTime int64, limit int) historyWindow {
+	if endTime == 0 {
+		endTime = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	if startTime == 0 {
+		startTime = endTime - capitalHistoryWindow.Milliseconds() + 1
+	}
+	if limit <= 0 || limit > maxCapitalHistoryLimit {
+		limit = maxCapitalHistoryLimit
+	}
+	w := historyWindow{start: startTime, last: endTime, limit: limit, done: startTime > endTime}
+	w.end = w.windowEnd()
+	return w
+}
+
+func (w *historyWindow) windowEnd() int64 {
+	end := w.start + capitalHistoryWindow.Milliseconds() - 1
+	if end > w.last {
+		end = w.last
+	}
+	return end
+}
+
+// advance move to the next page of the window if the last one was full, or to the next window
+func (w *historyWindow) advance(rows int) {
+	if rows >= w.limit {
+		w.offset += rows
+		return
+	}
+	w.offset = 0
+	w.start = w.end + 1
+	if w.start > w.last {
+		w.done = true
+		return
+	}
+	w.end = w.windowEnd()
+}
+
+// DepositHistoryIterator page through deposits of any time range, deposits are deduplicated by txId and network
+type DepositHistoryIterator struct {
+	bc     *Client
+	query  DepositHistoryQuery
+	window historyWindow
+	seen   map[string]bool
+	page   DepositsList
+	err    error
+}
+
+// NewDepositHistoryIterator create an iterator over deposits matching q, the range defaults to the last 90 days and
+// q.Offset is ignored
+func (bc *Client) NewDepositHistoryIterator(q DepositHistoryQuery) *DepositHistoryIterator {
+	return &DepositHistoryIterator{
+		bc:     bc,
+		query:  q,
+		window: newHistoryWindow(q.StartTime, q.EndTime, q.Limit),
+		seen:   make(map[string]bool),
+	}
+}
+
+// Next fetch the next non empty page, it returns false when there is no more deposit or an error occurred
+func (it *DepositHistoryIterator) Next() bool {
+	for !it.window.done && it.err == nil {
+		q := it.query
+		q.StartTime, q.EndTime = it.window.start, it.window.end
+		q.Offset, q.Limit = it.window.offset, it.window.limit
+		rows, _, err := it.bc.GetDepositHistory(q)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.window.advance(len(rows))
+		it.page = nil
+		for _, r := range rows {
+			key := r.TxId + "|" + r.Network
+			if r.TxId == "" {
+				key = "id|" + r.ID
+			}
+			if it.seen[key] {
+				continue
+			}
+			it.seen[key] = true
+			it.page = append(it.page, r)
+		}
+		if len(it.page) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Page return the deposits fetched by the last call to Next
+func (it *DepositHistoryIterator) Page() DepositsList {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *DepositHistoryIterator) Err() error {
+	return it.err
+}
+
+// WithdrawHistoryIterator page through withdrawals of any time range, withdrawals are deduplicated by id
+type WithdrawHistoryIterator struct {
+	bc     *Client
+	query  WithdrawHistoryQuery
+	window historyWindow
+	seen   map[string]bool
+	page   WithdrawalsList
+	err    error
+}
+
+// NewWithdrawHistoryIterator create an iterator over withdrawals matching q, the range defaults to the last 90 days
+// and q.Offset is ignored
+func (bc *Client) NewWithdrawHistoryIterator(q WithdrawHistoryQuery) *WithdrawHistoryIterator {
+	return &WithdrawHistoryIterator{
+		bc:     bc,
+		query:  q,
+		window: newHistoryWindow(q.StartTime, q.EndTime, q.Limit),
+		seen:   make(map[string]bool),
+	}
+}
+
+// Next fetch the next non empty page, it returns false when there is no more withdrawal or an error occurred
+func (it *WithdrawHistoryIterator) Next() bool {
+	for !it.window.done && it.err == nil {
+		q := it.query
+		q.StartTime, q.EndTime = it.window.start, it.window.end
+		q.Offset, q.Limit = it.window.offset, it.window.limit
+		rows, _, err := it.bc.GetWithdrawHistory(q)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.window.advance(len(rows))
+		it.page = nil
+		for _, r := range rows {
+			if it.seen[r.ID] {
+				continue
+			}
+			it.seen[r.ID] = true
+			it.page = append(it.page, r)
+		}
+		if len(it.page) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Page return the withdrawals fetched by the last call to Next
+func (it *WithdrawHistoryIterator) Page() WithdrawalsList {
+	return it.page
+}
+
+// Err return the error that stopped the iteration
+func (it *WithdrawHistoryIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,279 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+const (
+	pollNotFound = -1
+	pollFailed   = -2
+)
+
+func TestWithdrawTrackerTrack(t *testing.T) {
+	tests := []struct {
+		name            string
+		id              string
+		polls           []int // status returned by each poll, or pollNotFound / pollFailed
+		wantTransitions []WithdrawStatus
+		wantStatus      WithdrawStatus
+		wantErr         bool
+		wantPolls       int
+	}{
+		{
+			name:            "completed",
+			id:              "w1",
+			polls:           []int{pollNotFound, 4, 4, 6},
+			wantTransitions: []WithdrawStatus{WithdrawStatusProcessing, WithdrawStatusCompleted},
+			wantStatus:      WithdrawStatusCompleted,
+			wantPolls:       4,
+		},
+		{
+			name:      "never found",
+			id:        "w1",
+			polls:     []int{pollNotFound, pollNotFound, pollNotFound, 6},
+			wantErr:   true,
+			wantPolls: 3,
+		},
+		{
+			name:            "failed polls are not counted as not found",
+			id:              "w1",
+			polls:           []int{pollNotFound, pollFailed, pollFailed, pollNotFound, 5},
+			wantTransitions: []WithdrawStatus{WithdrawStatusFailure},
+			wantStatus:      WithdrawStatusFailure,
+			wantPolls:       5,
+		},
+		{
+			name:    "empty id",
+			id:      "",
+			polls:   []int{6},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			polls := 0
+			bc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/sapi/v1/capital/withdraw/history" {
+					t.Errorf("unexpected path %s", r.URL.Path)
+				}
+				if got := r.URL.Query().Get("idList"); got != tt.id {
+					t.Errorf("idList = %s, want %s", got, tt.id)
+				}
+				status := tt.polls[polls]
+				polls++
+				switch status {
+				case pollFailed:
+					w.WriteHeader(http.StatusInternalServerError)
+				case pollNotFound:
+					writeJSON(t, w, []interface{}{})
+				default:
+					writeJSON(t, w, []map[string]interface{}{
+						{"id": "other", "status": 6},
+						{"id": tt.id, "status": status},
+					})
+				}
+			})
+			tracker, err := bc.NewWithdrawTracker(time.Millisecond)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tracker.MaxNotFoundPolls = 3
+			var transitions []WithdrawStatus
+			tracker.OnTransition = func(entry WithdrawalEntry, previous *WithdrawStatus) {
+				transitions = append(transitions, entry.Status)
+			}
+			entry, err := tracker.Track(context.Background(), tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Track() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && entry.Status != tt.wantStatus {
+				t.Errorf("Track() status = %s, want %s", entry.Status, tt.wantStatus)
+			}
+			if !reflect.DeepEqual(transitions, tt.wantTransitions) {
+				t.Errorf("transitions = %v, want %v", transitions, tt.wantTransitions)
+			}
+			if polls != tt.wantPolls {
+				t.Errorf("%d polls, want %d", polls, tt.wantPolls)
+			}
+		})
+	}
+}
+
+func TestNewWithdrawTracker(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := (&Client{}).NewWithdrawTracker(interval); err == nil {
+			t.Errorf("NewWithdrawTracker(%s) error = nil, want an error", interval)
+		}
+	}
+}
+
+// capitalRecord is a deposit or withdrawal served by newCapitalHistoryServer
+type capitalRecord struct {
+	id      string
+	txID    string
+	network string
+	time    int64
+}
+
+// capitalHistoryCall is the range and offset of a history request
+type capitalHistoryCall struct {
+	start, end int64
+	offset     int
+}
+
+// newCapitalHistoryServer serve records of the deposit and withdrawal histories filtered by startTime and endTime,
+// most recent first and paged by offset and limit, like binance does
+func newCapitalHistoryServer(t *testing.T, records []capitalRecord, calls *[]capitalHistoryCall) *Client {
+	return newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.ParseInt(q.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(q.Get("endTime"), 10, 64)
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		if end-start >= capitalHistoryWindow.Milliseconds() {
+			t.Errorf("range %d-%d is longer than 90 days", start, end)
+		}
+		*calls = append(*calls, capitalHistoryCall{start: start, end: end, offset: offset})
+		var matched []capitalRecord
+		for _, rec := range records {
+			if rec.time >= start && rec.time <= end {
+				matched = append(matched, rec)
+			}
+		}
+		sort.SliceStable(matched, func(i, j int) bool { return matched[i].time > matched[j].time })
+		if offset > len(matched) {
+			offset = len(matched)
+		}
+		matched = matched[offset:]
+		if len(matched) > limit {
+			matched = matched[:limit]
+		}
+		rows := []map[string]interface{}{}
+		for _, rec := range matched {
+			row := map[string]interface{}{"id": rec.id, "txId": rec.txID, "network": rec.network}
+			switch r.URL.Path {
+			case "/sapi/v1/capital/deposit/hisrec":
+				row["insertTime"] = rec.time
+			case "/sapi/v1/capital/withdraw/history":
+				row["applyTime"] = time.Unix(0, rec.time*int64(time.Millisecond)).UTC().Format(dateTimeLayout)
+			default:
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			rows = append(rows, row)
+		}
+		writeJSON(t, w, rows)
+	})
+}
+
+func TestDepositHistoryIterator(t *testing.T) {
+	window := capitalHistoryWindow.Milliseconds()
+	end := int64(1700000000000)
+	tests := []struct {
+		name      string
+		query     DepositHistoryQuery
+		records   []capitalRecord
+		wantCalls []capitalHistoryCall
+		wantIDs   []string
+	}{
+		{
+			name:      "default range is a single window",
+			query:     DepositHistoryQuery{EndTime: end},
+			records:   []capitalRecord{{id: "1", txID: "a", network: "ETH", time: end - window + 1}},
+			wantCalls: []capitalHistoryCall{{start: end - window + 1, end: end}},
+			wantIDs:   []string{"1"},
+		},
+		{
+			name:  "windows and offset paging",
+			query: DepositHistoryQuery{StartTime: end - 2*window - 9, EndTime: end, Limit: 2},
+			records: []capitalRecord{
+				{id: "1", txID: "a", network: "ETH", time: end - 2*window - 9},
+				{id: "2", txID: "b", network: "ETH", time: end - 2*window - 8},
+				{id: "3", txID: "c", network: "ETH", time: end - window - 10},
+				{id: "4", txID: "d", network: "ETH", time: end},
+			},
+			wantCalls: []capitalHistoryCall{
+				{start: end - 2*window - 9, end: end - window - 10},
+				{start: end - 2*window - 9, end: end - window - 10, offset: 2},
+				{start: end - window - 9, end: end - 10},
+				{start: end - 9, end: end},
+			},
+			wantIDs: []string{"3", "2", "1", "4"},
+		},
+		{
+			name:  "duplicates by tx id and network",
+			query: DepositHistoryQuery{StartTime: end - 10, EndTime: end},
+			records: []capitalRecord{
+				{id: "1", txID: "a", network: "ETH", time: end - 3},
+				{id: "2", txID: "a", network: "ETH", time: end - 2},
+				{id: "3", txID: "a", network: "BSC", time: end - 1},
+				{id: "4", time: end},
+				{id: "4", time: end},
+				{id: "5", time: end},
+			},
+			wantCalls: []capitalHistoryCall{{start: end - 10, end: end}},
+			wantIDs:   []string{"4", "5", "3", "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []capitalHistoryCall
+			bc := newCapitalHistoryServer(t, tt.records, &calls)
+			it := bc.NewDepositHistoryIterator(tt.query)
+			var ids []string
+			for it.Next() {
+				for _, d := range it.Page() {
+					ids = append(ids, d.ID)
+				}
+			}
+			if err := it.Err(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("calls = %v, want %v", calls, tt.wantCalls)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestWithdrawHistoryIterator(t *testing.T) {
+	window := capitalHistoryWindow.Milliseconds()
+	end := int64(1700000000000)
+	records := []capitalRecord{
+		{id: "1", time: end - window - 5000},
+		{id: "2", time: end - 3000},
+		{id: "2", time: end - 3000},
+		{id: "3", time: end - 2000},
+	}
+	var calls []capitalHistoryCall
+	bc := newCapitalHistoryServer(t, records, &calls)
+	it := bc.NewWithdrawHistoryIterator(WithdrawHistoryQuery{StartTime: end - window - 5000, EndTime: end, Limit: 2})
+	var ids []string
+	for it.Next() {
+		for _, w := range it.Page() {
+			ids = append(ids, w.ID)
+		}
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"1", "3", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("ids = %v, want %v", ids, want)
+	}
+	wantCalls := []capitalHistoryCall{
+		{start: end - window - 5000, end: end - 5001},
+		{start: end - 5000, end: end},
+		{start: end - 5000, end: end, offset: 2},
+	}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", calls, wantCalls)
+	}
+}
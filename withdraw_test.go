@@ -0,0 +1,114 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestWithdrawRequestValidate(t *testing.T) {
+	coin := CoinInfo{
+		Coin: "XRP",
+		NetworkList: []CoinNetwork{
+			{
+				Coin:           "XRP",
+				Network:        "XRP",
+				IsDefault:      true,
+				WithdrawEnable: true,
+				WithdrawMin:    "20",
+				WithdrawMax:    "1000",
+				AddressRegex:   "^r[1-9A-HJ-NP-Za-km-z]{25,34}$",
+				MemoRegex:      "^[0-9]{1,10}$",
+				SameAddress:    true,
+			},
+			{
+				Coin:           "XRP",
+				Network:        "XRPL",
+				WithdrawEnable: true,
+				MemoRegex:      "^((?!0)[0-9]{1,10})$", // unsupported by RE2
+				SameAddress:    true,
+			},
+			{
+				Coin:           "XRP",
+				Network:        "BSC",
+				WithdrawEnable: false,
+				WithdrawMin:    "1",
+			},
+			{
+				Coin:           "XRP",
+				Network:        "ARB",
+				WithdrawEnable: true,
+				AddressRegex:   "^(?!0x0)[0-9a-zA-Z]+$", // unsupported by RE2
+			},
+		},
+	}
+	valid := WithdrawRequest{
+		Coin:       "XRP",
+		Network:    "XRP",
+		Address:    "rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh",
+		AddressTag: "12345",
+		Amount:     decimal.NewFromInt(50),
+	}
+	tests := []struct {
+		name            string
+		modify          func(r *WithdrawRequest)
+		wantErr         bool
+		wantUnsupported bool
+	}{
+		{name: "valid", modify: func(r *WithdrawRequest) {}},
+		{name: "default network", modify: func(r *WithdrawRequest) { r.Network = "" }},
+		{name: "funding wallet", modify: func(r *WithdrawRequest) { r.Wallet = FundingWallet }},
+		{name: "other coin", modify: func(r *WithdrawRequest) { r.Coin = "BTC" }, wantErr: true},
+		{name: "unknown network", modify: func(r *WithdrawRequest) { r.Network = "ETH" }, wantErr: true},
+		{name: "withdrawal disabled", modify: func(r *WithdrawRequest) { r.Network = "BSC" }, wantErr: true},
+		{name: "zero amount", modify: func(r *WithdrawRequest) { r.Amount = decimal.Zero }, wantErr: true},
+		{name: "below minimum", modify: func(r *WithdrawRequest) { r.Amount = decimal.NewFromInt(19) }, wantErr: true},
+		{name: "at minimum", modify: func(r *WithdrawRequest) { r.Amount = decimal.NewFromInt(20) }},
+		{name: "above maximum", modify: func(r *WithdrawRequest) { r.Amount = decimal.NewFromInt(1001) }, wantErr: true},
+		{name: "missing address", modify: func(r *WithdrawRequest) { r.Address = "" }, wantErr: true},
+		{name: "malformed address", modify: func(r *WithdrawRequest) { r.Address = "0xabc" }, wantErr: true},
+		{name: "missing tag", modify: func(r *WithdrawRequest) { r.AddressTag = "" }, wantErr: true},
+		{name: "malformed tag", modify: func(r *WithdrawRequest) { r.AddressTag = "memo" }, wantErr: true},
+		{
+			name:            "unsupported tag regex",
+			modify:          func(r *WithdrawRequest) { r.Network = "XRPL" },
+			wantErr:         true,
+			wantUnsupported: true,
+		},
+		{
+			name:            "unsupported address regex",
+			modify:          func(r *WithdrawRequest) { r.Network, r.AddressTag = "ARB", "" },
+			wantErr:         true,
+			wantUnsupported: true,
+		},
+		{
+			name:   "unsupported regex skipped",
+			modify: func(r *WithdrawRequest) { r.Network, r.AddressTag, r.SkipUnsupportedRegex = "XRPL", "memo", true },
+		},
+		{
+			name:    "other errors before unsupported regex",
+			modify:  func(r *WithdrawRequest) { r.Network, r.Wallet = "XRPL", CrossMarginWallet },
+			wantErr: true,
+		},
+		{
+			name:    "missing tag with unsupported regex skipped",
+			modify:  func(r *WithdrawRequest) { r.Network, r.AddressTag, r.SkipUnsupportedRegex = "XRPL", "", true },
+			wantErr: true,
+		},
+		{name: "margin wallet", modify: func(r *WithdrawRequest) { r.Wallet = CrossMarginWallet }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid
+			tt.modify(&r)
+			err := r.Validate(coin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrAddressRegexUnsupported) != tt.wantUnsupported {
+				t.Errorf("Validate() error = %v, want ErrAddressRegexUnsupported %v", err, tt.wantUnsupported)
+			}
+		})
+	}
+}